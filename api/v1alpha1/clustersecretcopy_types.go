@@ -0,0 +1,127 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterSecretCopySpec defines the desired state of ClusterSecretCopy
+type ClusterSecretCopySpec struct {
+	// SourceNamespaceSelector selects namespaces to take source Secrets from, all namespaces when not set
+	// +optional
+	SourceNamespaceSelector *metav1.LabelSelector `json:"sourceNamespaceSelector,omitempty"`
+
+	// SecretSelector selects source Secrets by labels, must not be empty
+	SecretSelector metav1.LabelSelector `json:"secretSelector"`
+
+	// DestinationClusterSelector selects kubeconfig Secrets of destination clusters by labels, must not be empty
+	DestinationClusterSelector metav1.LabelSelector `json:"destinationClusterSelector"`
+
+	// DestinationNamespace in destination clusters, defaults to the namespace of each source Secret
+	// +optional
+	DestinationNamespace string `json:"destinationNamespace,omitempty"`
+
+	// Strategy defines behavior when the destination secret exists
+	// +kubebuilder:validation:Enum=overwrite;ignore;merge
+	// +kubebuilder:default=overwrite
+	// +optional
+	Strategy string `json:"strategy,omitempty"`
+
+	// Type overrides the destination secret type, defaults to the source type
+	// +optional
+	Type corev1.SecretType `json:"type,omitempty"`
+
+	// DeletionPolicy defines what happens to copies when they are no longer wanted
+	// +kubebuilder:validation:Enum=Orphan;Delete
+	// +kubebuilder:default=Orphan
+	// +optional
+	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+}
+
+// ClusterSyncedTarget identifies a copy of a selected source Secret written to a destination cluster
+type ClusterSyncedTarget struct {
+	// Source is the source Secret reference (namespace/name)
+	Source string `json:"source"`
+
+	SyncedTarget `json:",inline"`
+}
+
+// ClusterSecretCopyStatus defines the observed state of ClusterSecretCopy
+type ClusterSecretCopyStatus struct {
+	// ObservedGeneration is the generation last processed by the controller
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastSyncTime is the time of the last sync attempt
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// RetryCount is the number of consecutive failed syncs, used for exponential backoff
+	// +optional
+	RetryCount int32 `json:"retryCount,omitempty"`
+
+	// SourceCount is the number of source Secrets matched by the selectors
+	// +optional
+	SourceCount int32 `json:"sourceCount,omitempty"`
+
+	// ClusterCount is the number of destination clusters matched by the selector
+	// +optional
+	ClusterCount int32 `json:"clusterCount,omitempty"`
+
+	// SyncedTargets lists copies written to destination clusters
+	// +optional
+	SyncedTargets []ClusterSyncedTarget `json:"syncedTargets,omitempty"`
+
+	// Conditions represent the latest observations of the ClusterSecretCopy state
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Sources",type=integer,JSONPath=`.status.sourceCount`
+// +kubebuilder:printcolumn:name="Clusters",type=integer,JSONPath=`.status.clusterCount`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ClusterSecretCopy is the Schema for the clustersecretcopies API
+type ClusterSecretCopy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterSecretCopySpec   `json:"spec,omitempty"`
+	Status ClusterSecretCopyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterSecretCopyList contains a list of ClusterSecretCopy
+type ClusterSecretCopyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterSecretCopy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterSecretCopy{}, &ClusterSecretCopyList{})
+}
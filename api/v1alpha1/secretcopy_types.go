@@ -0,0 +1,159 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Condition types and reasons for SecretCopy and ClusterSecretCopy status
+const (
+	// ConditionReady indicates that the source secret is copied to all destinations
+	ConditionReady = "Ready"
+
+	// ReasonSynced means all destinations are up to date
+	ReasonSynced = "Synced"
+	// ReasonSyncFailed means at least one destination failed
+	ReasonSyncFailed = "SyncFailed"
+	// ReasonInvalidSpec means the spec cannot be applied
+	ReasonInvalidSpec = "InvalidSpec"
+	// ReasonSourceNotFound means the source secret does not exist
+	ReasonSourceNotFound = "SourceNotFound"
+	// ReasonNoMatch means selectors of a ClusterSecretCopy match no source secrets or clusters
+	ReasonNoMatch = "NoMatch"
+)
+
+// SourceReference references the Secret to copy in the SecretCopy namespace
+type SourceReference struct {
+	// Name of the source Secret
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// KubeconfigSecretReference references a Secret with kubeconfig of a destination cluster
+type KubeconfigSecretReference struct {
+	// Namespace of the kubeconfig Secret
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+	// Name of the kubeconfig Secret
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// Destination describes where the source secret is copied to
+type Destination struct {
+	// KubeconfigSecretRef references the kubeconfig Secret of the destination cluster
+	KubeconfigSecretRef KubeconfigSecretReference `json:"kubeconfigSecretRef"`
+	// Namespace in the destination cluster, defaults to the SecretCopy namespace
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// SecretCopySpec defines the desired state of SecretCopy
+type SecretCopySpec struct {
+	// Source references the Secret to copy
+	Source SourceReference `json:"source"`
+
+	// Destinations lists destination clusters and namespaces, each is synced independently
+	// +kubebuilder:validation:MinItems=1
+	Destinations []Destination `json:"destinations"`
+
+	// FieldsMapping maps source keys to destination keys, only mapped keys are copied when set
+	// +optional
+	FieldsMapping map[string]string `json:"fieldsMapping,omitempty"`
+
+	// Strategy defines behavior when the destination secret exists
+	// +kubebuilder:validation:Enum=overwrite;ignore;merge
+	// +kubebuilder:default=overwrite
+	// +optional
+	Strategy string `json:"strategy,omitempty"`
+
+	// Type overrides the destination secret type, defaults to the source type
+	// +optional
+	Type corev1.SecretType `json:"type,omitempty"`
+
+	// DeletionPolicy defines what happens to copies when they are no longer wanted
+	// +kubebuilder:validation:Enum=Orphan;Delete
+	// +kubebuilder:default=Orphan
+	// +optional
+	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+}
+
+// SyncedTarget identifies a copy written to a destination cluster
+type SyncedTarget struct {
+	// Cluster is the kubeconfig Secret reference (namespace/name)
+	Cluster string `json:"cluster"`
+	// Namespace of the copy
+	Namespace string `json:"namespace"`
+	// Name of the copy
+	Name string `json:"name"`
+}
+
+// SecretCopyStatus defines the observed state of SecretCopy
+type SecretCopyStatus struct {
+	// ObservedGeneration is the generation last processed by the controller
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// LastSyncTime is the time of the last sync attempt
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// RetryCount is the number of consecutive failed syncs, used for exponential backoff
+	// +optional
+	RetryCount int32 `json:"retryCount,omitempty"`
+
+	// SyncedTargets lists copies written to destination clusters
+	// +optional
+	SyncedTargets []SyncedTarget `json:"syncedTargets,omitempty"`
+
+	// Conditions represent the latest observations of the SecretCopy state
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Source",type=string,JSONPath=`.spec.source.name`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// SecretCopy is the Schema for the secretcopies API
+type SecretCopy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SecretCopySpec   `json:"spec,omitempty"`
+	Status SecretCopyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SecretCopyList contains a list of SecretCopy
+type SecretCopyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SecretCopy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SecretCopy{}, &SecretCopyList{})
+}
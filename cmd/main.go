@@ -20,6 +20,7 @@ import (
 	"crypto/tls"
 	"flag"
 	"os"
+	"strings"
 	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
@@ -30,13 +31,16 @@ import (
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
+	secretcopyv1alpha1 "secret-copy-operator/api/v1alpha1"
 	"secret-copy-operator/internal/controller"
+	webhookv1 "secret-copy-operator/internal/webhook/v1"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -48,6 +52,7 @@ var (
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
+	utilruntime.Must(secretcopyv1alpha1.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
 }
 
@@ -63,6 +68,12 @@ func main() {
 	var clientCacheTTL time.Duration
 	var maxConcurrentReconciles int
 	var clusterName string
+	var resyncPeriod time.Duration
+	var watchDestinations bool
+	var enableWebhooks bool
+	var kubeconfigKey string
+	var enableClusterAPI bool
+	var enableConfigMaps bool
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
@@ -87,6 +98,24 @@ func main() {
 		"Maximum number of concurrent reconciles")
 	flag.StringVar(&clusterName, "cluster-name", "system",
 		"Name of this cluster (written to copied secrets as sourceCluster)")
+	flag.DurationVar(&resyncPeriod, "resync-period", 0,
+		"Interval for re-verifying copies after a successful sync, 0 disables periodic resync")
+	flag.BoolVar(&watchDestinations, "watch-destinations", false,
+		"If set, copied secrets are watched in destination clusters and restored when modified or deleted. "+
+			"Requires list/watch on secrets in destination clusters.")
+	flag.StringVar(&kubeconfigKey, "kubeconfig-key", "",
+		"Key holding kubeconfig in kubeconfig secrets. If empty, well-known keys are tried: "+
+			strings.Join(controller.DefaultKubeconfigKeys, ", ")+". "+
+			"Overridden per secret by the "+controller.AnnotationKubeconfigKey+" annotation.")
+	flag.BoolVar(&enableClusterAPI, "enable-cluster-api", false,
+		"If set, Cluster API Clusters are watched and secrets are copied as soon as a control plane becomes ready. "+
+			"Requires Cluster API CRDs to be installed.")
+	flag.BoolVar(&enableConfigMaps, "enable-configmaps", false,
+		"If set, labeled ConfigMaps are copied like secrets. "+
+			"Caches all ConfigMaps of the cluster, which increases memory usage.")
+	flag.BoolVar(&enableWebhooks, "enable-webhooks", false,
+		"If set, the validating webhook for secret-copy annotations is registered. "+
+			"Requires webhook certificates, see --webhook-cert-path.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -105,6 +134,11 @@ func main() {
 		os.Exit(1)
 	}
 
+	if resyncPeriod < 0 {
+		setupLog.Error(nil, "invalid resync-period value, must not be negative", "value", resyncPeriod)
+		os.Exit(1)
+	}
+
 	// if the enable-http2 flag is false (the default), http/2 should be disabled
 	// due to its vulnerabilities. More specifically, disabling http/2 will
 	// prevent from being vulnerable to the HTTP/2 Stream Cancellation and
@@ -196,18 +230,86 @@ func main() {
 		os.Exit(1)
 	}
 
-	// Setup SecretCopy controller
+	ctx := ctrl.SetupSignalHandler()
+
+	clusterManager := controller.NewClusterManager(clientCacheTTL, mgr.GetScheme(), maxConcurrentReconciles, kubeconfigKey)
+	var secretDriftEvents, configMapDriftEvents <-chan event.GenericEvent
+	if watchDestinations {
+		clusterManager.EnableDriftDetection(ctx, mgr.GetAPIReader())
+		secretDriftEvents = clusterManager.DriftEvents(controller.KindSecret)
+		if enableConfigMaps {
+			configMapDriftEvents = clusterManager.DriftEvents(controller.KindConfigMap)
+		}
+	}
+
+	// Setup annotation-based Secret and ConfigMap controllers and SecretCopy/ClusterSecretCopy resource controllers
 	if err = (&controller.SecretCopyReconciler{
 		Client:                  mgr.GetClient(),
 		Scheme:                  mgr.GetScheme(),
-		ClusterClientGetter:     controller.NewClusterManager(clientCacheTTL, mgr.GetScheme(), maxConcurrentReconciles),
+		ClusterClientGetter:     clusterManager,
 		MaxConcurrentReconciles: maxConcurrentReconciles,
 		ClusterName:             clusterName,
+		ResyncPeriod:            resyncPeriod,
+		DriftEvents:             secretDriftEvents,
+		Recorder:                mgr.GetEventRecorderFor("secret-copy-operator"),
+		WatchClusterAPI:         enableClusterAPI,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Secret")
+		os.Exit(1)
+	}
+	if enableConfigMaps {
+		if err = (&controller.ConfigMapCopyReconciler{
+			SecretCopyReconciler: controller.SecretCopyReconciler{
+				Client:                  mgr.GetClient(),
+				Scheme:                  mgr.GetScheme(),
+				ClusterClientGetter:     clusterManager,
+				MaxConcurrentReconciles: maxConcurrentReconciles,
+				ClusterName:             clusterName,
+				ResyncPeriod:            resyncPeriod,
+				DriftEvents:             configMapDriftEvents,
+				Recorder:                mgr.GetEventRecorderFor("secret-copy-operator"),
+				WatchClusterAPI:         enableClusterAPI,
+			},
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "ConfigMap")
+			os.Exit(1)
+		}
+	}
+	if err = (&controller.SecretCopyResourceReconciler{
+		SecretCopyReconciler: controller.SecretCopyReconciler{
+			Client:                  mgr.GetClient(),
+			Scheme:                  mgr.GetScheme(),
+			ClusterClientGetter:     clusterManager,
+			MaxConcurrentReconciles: maxConcurrentReconciles,
+			ClusterName:             clusterName,
+			ResyncPeriod:            resyncPeriod,
+			Recorder:                mgr.GetEventRecorderFor("secret-copy-operator"),
+		},
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "SecretCopy")
 		os.Exit(1)
 	}
+	if err = (&controller.ClusterSecretCopyReconciler{
+		SecretCopyReconciler: controller.SecretCopyReconciler{
+			Client:                  mgr.GetClient(),
+			Scheme:                  mgr.GetScheme(),
+			ClusterClientGetter:     clusterManager,
+			MaxConcurrentReconciles: maxConcurrentReconciles,
+			ClusterName:             clusterName,
+			ResyncPeriod:            resyncPeriod,
+			Recorder:                mgr.GetEventRecorderFor("secret-copy-operator"),
+		},
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ClusterSecretCopy")
+		os.Exit(1)
+	}
 
+	if enableWebhooks {
+		if err = webhookv1.SetupSecretWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Secret")
+			os.Exit(1)
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -220,7 +322,7 @@ func main() {
 	}
 
 	setupLog.Info("starting manager")
-	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
+	if err := mgr.Start(ctx); err != nil {
 		setupLog.Error(err, "problem running manager")
 		os.Exit(1)
 	}
@@ -0,0 +1,131 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/rest"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	// LabelArgoCDSecretType marks Argo CD credential secrets
+	LabelArgoCDSecretType = "argocd.argoproj.io/secret-type"
+	// ArgoCDSecretTypeCluster is the LabelArgoCDSecretType value of cluster secrets
+	ArgoCDSecretTypeCluster = "cluster"
+)
+
+// argoCDClusterConfig is the "config" key of an Argo CD cluster secret
+type argoCDClusterConfig struct {
+	Username           string                    `json:"username,omitempty"`
+	Password           string                    `json:"password,omitempty"`
+	BearerToken        string                    `json:"bearerToken,omitempty"`
+	TLSClientConfig    argoCDTLSClientConfig     `json:"tlsClientConfig"`
+	ExecProviderConfig *argoCDExecProviderConfig `json:"execProviderConfig,omitempty"`
+	AWSAuthConfig      json.RawMessage           `json:"awsAuthConfig,omitempty"`
+}
+
+// argoCDTLSClientConfig holds TLS settings, certificate data is PEM encoded in base64
+type argoCDTLSClientConfig struct {
+	Insecure   bool   `json:"insecure"`
+	ServerName string `json:"serverName,omitempty"`
+	CertData   []byte `json:"certData,omitempty"`
+	KeyData    []byte `json:"keyData,omitempty"`
+	CAData     []byte `json:"caData,omitempty"`
+}
+
+// argoCDExecProviderConfig configures an exec credential plugin
+type argoCDExecProviderConfig struct {
+	Command     string            `json:"command,omitempty"`
+	Args        []string          `json:"args,omitempty"`
+	Env         map[string]string `json:"env,omitempty"`
+	APIVersion  string            `json:"apiVersion,omitempty"`
+	InstallHint string            `json:"installHint,omitempty"`
+}
+
+// isArgoCDClusterSecret returns true if the secret is an Argo CD cluster secret
+func isArgoCDClusterSecret(secret *corev1.Secret) bool {
+	return secret.Labels[LabelArgoCDSecretType] == ArgoCDSecretTypeCluster
+}
+
+// getArgoCDClusterData returns the connection data of an Argo CD cluster secret,
+// used as the cache hash input like kubeconfig data
+func getArgoCDClusterData(secret *corev1.Secret) ([]byte, error) {
+	server := secret.Data["server"]
+	if len(server) == 0 {
+		return nil, fmt.Errorf("server not found in Argo CD cluster secret %s/%s", secret.Namespace, secret.Name)
+	}
+
+	data := make([]byte, 0, len(server)+len(secret.Data["config"])+1)
+	data = append(data, server...)
+	data = append(data, '\n')
+	return append(data, secret.Data["config"]...), nil
+}
+
+// restConfigFromArgoCDSecret builds a REST config from the server and config keys of an Argo CD cluster secret
+func restConfigFromArgoCDSecret(secret *corev1.Secret) (*rest.Config, error) {
+	var config argoCDClusterConfig
+	if raw := secret.Data["config"]; len(raw) > 0 {
+		if err := json.Unmarshal(raw, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse Argo CD cluster config: %w", err)
+		}
+	}
+	// Argo CD runs argocd-k8s-auth for AWS, which is not available to the operator
+	if len(config.AWSAuthConfig) > 0 && string(config.AWSAuthConfig) != "null" {
+		return nil, fmt.Errorf("awsAuthConfig in Argo CD cluster secret is not supported, use execProviderConfig")
+	}
+
+	restConfig := &rest.Config{
+		Host:        string(secret.Data["server"]),
+		Username:    config.Username,
+		Password:    config.Password,
+		BearerToken: config.BearerToken,
+		TLSClientConfig: rest.TLSClientConfig{
+			Insecure:   config.TLSClientConfig.Insecure,
+			ServerName: config.TLSClientConfig.ServerName,
+			CertData:   config.TLSClientConfig.CertData,
+			KeyData:    config.TLSClientConfig.KeyData,
+			CAData:     config.TLSClientConfig.CAData,
+		},
+	}
+
+	if exec := config.ExecProviderConfig; exec != nil {
+		env := make([]clientcmdapi.ExecEnvVar, 0, len(exec.Env))
+		for name, value := range exec.Env {
+			env = append(env, clientcmdapi.ExecEnvVar{Name: name, Value: value})
+		}
+		// Map order is random, keep the config stable
+		sort.Slice(env, func(i, j int) bool {
+			return env[i].Name < env[j].Name
+		})
+
+		restConfig.ExecProvider = &clientcmdapi.ExecConfig{
+			Command:         exec.Command,
+			Args:            exec.Args,
+			Env:             env,
+			APIVersion:      exec.APIVersion,
+			InstallHint:     exec.InstallHint,
+			InteractiveMode: clientcmdapi.NeverExecInteractiveMode,
+		}
+	}
+
+	return restConfig, nil
+}
@@ -20,7 +20,7 @@ import (
 	"strconv"
 	"time"
 
-	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
@@ -39,11 +39,8 @@ func calculateBackoff(retryCount int) time.Duration {
 }
 
 // getRetryCount reads retry count from annotation
-func getRetryCount(secret *corev1.Secret) int {
-	if secret.Annotations == nil {
-		return 0
-	}
-	countStr := secret.Annotations[AnnotationRetryCount]
+func getRetryCount(source client.Object) int {
+	countStr := source.GetAnnotations()[AnnotationRetryCount]
 	if countStr == "" {
 		return 0
 	}
@@ -17,17 +17,35 @@ limitations under the License.
 package controller
 
 import (
+	"context"
 	"crypto/sha256"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	toolscache "k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
+// driftEventsBuffer is the capacity of each channel with drift events
+const driftEventsBuffer = 1024
+
+// DefaultKubeconfigKeys are well-known keys probed in order when the kubeconfig key is not configured:
+// "value" (Cluster API, certs operator), "kubeconfig", "config" and "kubeconfig.yaml"
+var DefaultKubeconfigKeys = []string{"value", "kubeconfig", "config", "kubeconfig.yaml"}
+
 // ClusterManager manages connections to remote clusters with caching
 type ClusterManager struct {
 	mu                      sync.RWMutex
@@ -35,32 +53,62 @@ type ClusterManager struct {
 	ttl                     time.Duration
 	scheme                  *runtime.Scheme
 	maxConcurrentReconciles int
+	// kubeconfigKey is the key holding kubeconfig in every kubeconfig secret, empty enables auto-detection
+	kubeconfigKey string
+
+	// Drift detection, enabled by EnableDriftDetection
+	watchCtx    context.Context
+	watches     map[string]*clusterWatch
+	driftEvents map[string]chan event.GenericEvent // source kind -> events of copies of that kind
+	// kubeconfigReader reads kubeconfig secrets in the operator cluster to stop watches of removed clusters
+	kubeconfigReader client.Reader
 }
 
 type cachedClient struct {
 	client         client.Client
+	restConfig     *rest.Config
 	kubeconfigHash string
 	createdAt      time.Time
 }
 
-// NewClusterManager creates a new ClusterManager
-func NewClusterManager(ttl time.Duration, scheme *runtime.Scheme, maxConcurrentReconciles int) *ClusterManager {
+// clusterWatch is a running informer for copied secrets in a destination cluster
+type clusterWatch struct {
+	kubeconfigHash string
+	cancel         context.CancelFunc
+}
+
+// NewClusterManager creates a new ClusterManager. kubeconfigKey selects the key holding
+// kubeconfig in kubeconfig secrets, empty probes DefaultKubeconfigKeys.
+func NewClusterManager(
+	ttl time.Duration,
+	scheme *runtime.Scheme,
+	maxConcurrentReconciles int,
+	kubeconfigKey string,
+) *ClusterManager {
 	cm := &ClusterManager{
 		clients:                 make(map[string]*cachedClient),
 		ttl:                     ttl,
 		scheme:                  scheme,
 		maxConcurrentReconciles: maxConcurrentReconciles,
+		kubeconfigKey:           kubeconfigKey,
 	}
 	go cm.cleanupLoop()
 	return cm
 }
 
-// GetClient returns a cached client or creates a new one
+// GetClient returns a cached client or creates a new one. The secret holds either
+// a kubeconfig or, if labeled as an Argo CD cluster secret, Argo CD connection settings.
 func (cm *ClusterManager) GetClient(kubeconfigSecret *corev1.Secret) (client.Client, error) {
-	// Get kubeconfig from secret
-	kubeconfigData := cm.getKubeconfigFromSecret(kubeconfigSecret)
-	if kubeconfigData == nil {
-		return nil, fmt.Errorf("kubeconfig not found in secret %s/%s", kubeconfigSecret.Namespace, kubeconfigSecret.Name)
+	// Get kubeconfig or Argo CD connection settings from secret
+	var kubeconfigData []byte
+	var err error
+	if isArgoCDClusterSecret(kubeconfigSecret) {
+		kubeconfigData, err = getArgoCDClusterData(kubeconfigSecret)
+	} else {
+		kubeconfigData, err = cm.getKubeconfigFromSecret(kubeconfigSecret)
+	}
+	if err != nil {
+		return nil, err
 	}
 
 	cacheKey := kubeconfigSecret.Namespace + "/" + kubeconfigSecret.Name
@@ -70,7 +118,7 @@ func (cm *ClusterManager) GetClient(kubeconfigSecret *corev1.Secret) (client.Cli
 	cm.mu.RLock()
 	if cached, ok := cm.clients[cacheKey]; ok {
 		// Check TTL and that kubeconfig hasn't changed
-		if time.Since(cached.createdAt) < cm.ttl && cached.kubeconfigHash == configHash {
+		if cm.isFresh(cached, configHash) && cm.isWatched(cacheKey, configHash) {
 			cm.mu.RUnlock()
 			return cached.client, nil
 		}
@@ -79,16 +127,26 @@ func (cm *ClusterManager) GetClient(kubeconfigSecret *corev1.Secret) (client.Cli
 	cm.mu.Lock()
 	defer cm.mu.Unlock()
 
-	if cached, ok := cm.clients[cacheKey]; ok {
-		if time.Since(cached.createdAt) < cm.ttl && cached.kubeconfigHash == configHash {
-			return cached.client, nil
+	if cached, ok := cm.clients[cacheKey]; ok && cm.isFresh(cached, configHash) {
+		// A drift watch that failed to start is retried with the cached client
+		if cm.watches != nil {
+			cm.ensureWatch(cacheKey, configHash, cached.restConfig)
 		}
+		return cached.client, nil
 	}
 
 	// Create REST config from kubeconfig
-	restConfig, err := clientcmd.RESTConfigFromKubeConfig(kubeconfigData)
+	var restConfig *rest.Config
+	if isArgoCDClusterSecret(kubeconfigSecret) {
+		restConfig, err = restConfigFromArgoCDSecret(kubeconfigSecret)
+	} else {
+		restConfig, err = clientcmd.RESTConfigFromKubeConfig(kubeconfigData)
+		if err != nil {
+			err = fmt.Errorf("failed to parse kubeconfig: %w", err)
+		}
+	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse kubeconfig: %w", err)
+		return nil, err
 	}
 
 	// Configure timeouts and rate limits based on concurrency
@@ -108,17 +166,183 @@ func (cm *ClusterManager) GetClient(kubeconfigSecret *corev1.Secret) (client.Cli
 	// Cache the client
 	cm.clients[cacheKey] = &cachedClient{
 		client:         cl,
+		restConfig:     restConfig,
 		kubeconfigHash: configHash,
 		createdAt:      time.Now(),
 	}
 
+	if cm.watches != nil {
+		cm.ensureWatch(cacheKey, configHash, restConfig)
+	}
+
 	return cl, nil
 }
 
-// getKubeconfigFromSecret extracts kubeconfig from secret
-// Kubeconfig is always stored under "value" key (generated by certs operator)
-func (cm *ClusterManager) getKubeconfigFromSecret(secret *corev1.Secret) []byte {
-	return secret.Data["value"]
+// isFresh returns true if the cached client is within TTL and built from the current kubeconfig.
+// Must be called with cm.mu held.
+func (cm *ClusterManager) isFresh(cached *cachedClient, configHash string) bool {
+	return time.Since(cached.createdAt) < cm.ttl && cached.kubeconfigHash == configHash
+}
+
+// isWatched returns true if drift detection is disabled or a watch for the kubeconfig is running.
+// Must be called with cm.mu held.
+func (cm *ClusterManager) isWatched(cacheKey, configHash string) bool {
+	if cm.watches == nil {
+		return true
+	}
+	w, ok := cm.watches[cacheKey]
+	return ok && w.kubeconfigHash == configHash
+}
+
+// EnableDriftDetection makes the manager watch copied secrets in every destination
+// cluster it creates a client for. Modified or deleted copies are reported through
+// DriftEvents until ctx is cancelled. Watches outlive cached clients and are stopped
+// once reader no longer finds the kubeconfig secret. Must be called before the first GetClient.
+func (cm *ClusterManager) EnableDriftDetection(ctx context.Context, reader client.Reader) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	cm.watchCtx = ctx
+	cm.kubeconfigReader = reader
+	cm.watches = make(map[string]*clusterWatch)
+	cm.driftEvents = make(map[string]chan event.GenericEvent)
+}
+
+// DriftEvents returns the channel with modified or deleted copies of sources of the kind.
+// Copies of kinds nobody asked for are not reported. Must be called after EnableDriftDetection
+// and before the first GetClient.
+func (cm *ClusterManager) DriftEvents(kind string) <-chan event.GenericEvent {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	if _, ok := cm.driftEvents[kind]; !ok {
+		cm.driftEvents[kind] = make(chan event.GenericEvent, driftEventsBuffer)
+	}
+	return cm.driftEvents[kind]
+}
+
+// ensureWatch starts an informer for the cluster or restarts it when kubeconfig changed.
+// A watch that fails to start is not recorded, so the next GetClient retries it.
+// Must be called with cm.mu held.
+func (cm *ClusterManager) ensureWatch(cacheKey, configHash string, restConfig *rest.Config) {
+	if cm.isWatched(cacheKey, configHash) {
+		return
+	}
+	cm.stopWatch(cacheKey)
+
+	// Watches are long-running requests, the client timeout would break them
+	watchConfig := rest.CopyConfig(restConfig)
+	watchConfig.Timeout = 0
+
+	clientset, err := kubernetes.NewForConfig(watchConfig)
+	if err != nil {
+		log.FromContext(cm.watchCtx).Error(err, "Failed to create drift watch", "cluster", cacheKey)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(cm.watchCtx)
+
+	// Only copies carry the copy label, keep the informer small
+	factory := informers.NewSharedInformerFactoryWithOptions(clientset, 0,
+		informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+			opts.LabelSelector = LabelCopy + "=true"
+		}),
+	)
+	informer := factory.Core().V1().Secrets().Informer()
+	if err := informer.SetTransform(stripSecretData); err != nil {
+		cancel()
+		log.FromContext(ctx).Error(err, "Failed to set informer transform", "cluster", cacheKey)
+		return
+	}
+	if _, err := informer.AddEventHandler(cm.driftHandler(ctx)); err != nil {
+		cancel()
+		log.FromContext(ctx).Error(err, "Failed to add drift handler", "cluster", cacheKey)
+		return
+	}
+
+	cm.watches[cacheKey] = &clusterWatch{
+		kubeconfigHash: configHash,
+		cancel:         cancel,
+	}
+	log.FromContext(ctx).Info("Starting drift watch", "cluster", cacheKey)
+	factory.Start(ctx.Done())
+}
+
+// stopWatch stops the drift watch of the cluster if one is running.
+// Must be called with cm.mu held.
+func (cm *ClusterManager) stopWatch(cacheKey string) {
+	if w, ok := cm.watches[cacheKey]; ok {
+		w.cancel()
+		delete(cm.watches, cacheKey)
+	}
+}
+
+// driftHandler reports updated and deleted copies to the channel of their source kind;
+// initial list events are ignored
+func (cm *ClusterManager) driftHandler(ctx context.Context) toolscache.ResourceEventHandlerFuncs {
+	send := func(obj interface{}) {
+		if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		secret, ok := obj.(*corev1.Secret)
+		if !ok || secret.Annotations[AnnotationSourceSecret] == "" {
+			return
+		}
+		cm.mu.RLock()
+		events := cm.driftEvents[copySourceKind(secret)]
+		cm.mu.RUnlock()
+		if events == nil {
+			return
+		}
+		select {
+		case events <- event.GenericEvent{Object: secret}:
+		case <-ctx.Done():
+		}
+	}
+
+	return toolscache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldSecret, ok1 := oldObj.(*corev1.Secret)
+			newSecret, ok2 := newObj.(*corev1.Secret)
+			// Periodic informer resyncs deliver unchanged objects
+			if ok1 && ok2 && oldSecret.ResourceVersion == newSecret.ResourceVersion {
+				return
+			}
+			send(newObj)
+		},
+		DeleteFunc: send,
+	}
+}
+
+// stripSecretData drops secret payload from informer cache, only metadata is needed
+func stripSecretData(obj interface{}) (interface{}, error) {
+	if secret, ok := obj.(*corev1.Secret); ok {
+		secret.Data = nil
+		secret.StringData = nil
+		secret.ManagedFields = nil
+	}
+	return obj, nil
+}
+
+// getKubeconfigFromSecret extracts kubeconfig from secret. The key is taken from the
+// kubeconfigKey annotation of the secret, then from the operator flag, otherwise
+// the first non-empty of DefaultKubeconfigKeys is used.
+func (cm *ClusterManager) getKubeconfigFromSecret(secret *corev1.Secret) ([]byte, error) {
+	keys := DefaultKubeconfigKeys
+	if key := secret.Annotations[AnnotationKubeconfigKey]; key != "" {
+		keys = []string{key}
+	} else if cm.kubeconfigKey != "" {
+		keys = []string{cm.kubeconfigKey}
+	}
+
+	for _, key := range keys {
+		if data := secret.Data[key]; len(data) > 0 {
+			return data, nil
+		}
+	}
+
+	return nil, fmt.Errorf("kubeconfig not found in secret %s/%s, tried keys: %s",
+		secret.Namespace, secret.Name, strings.Join(keys, ", "))
 }
 
 // hashKubeconfig returns hash of kubeconfig for change detection
@@ -133,13 +357,46 @@ func (cm *ClusterManager) cleanupLoop() {
 	defer ticker.Stop()
 
 	for range ticker.C {
-		cm.mu.Lock()
-		now := time.Now()
-		for key, cached := range cm.clients {
-			if now.Sub(cached.createdAt) > cm.ttl {
-				delete(cm.clients, key)
-			}
+		cm.evictExpired(time.Now())
+		cm.pruneWatches()
+	}
+}
+
+// evictExpired removes clients older than TTL. Drift watches keep running, so that drift
+// is repaired without periodic resync, clusters still in use get a new client on the next GetClient.
+func (cm *ClusterManager) evictExpired(now time.Time) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	for key, cached := range cm.clients {
+		if now.Sub(cached.createdAt) > cm.ttl {
+			delete(cm.clients, key)
 		}
+	}
+}
+
+// pruneWatches stops drift watches of clusters whose kubeconfig secret was deleted
+func (cm *ClusterManager) pruneWatches() {
+	cm.mu.RLock()
+	ctx, reader := cm.watchCtx, cm.kubeconfigReader
+	keys := make([]string, 0, len(cm.watches))
+	for key := range cm.watches {
+		keys = append(keys, key)
+	}
+	cm.mu.RUnlock()
+	if reader == nil {
+		return
+	}
+
+	for _, key := range keys {
+		namespace, name, _ := strings.Cut(key, "/")
+		err := reader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, &corev1.Secret{})
+		if !errors.IsNotFound(err) {
+			continue
+		}
+		log.FromContext(ctx).Info("Stopping drift watch of removed cluster", "cluster", key)
+		cm.mu.Lock()
+		cm.stopWatch(key)
 		cm.mu.Unlock()
 	}
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package controller
 
 import (
+	"context"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -24,6 +25,10 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 )
 
 var _ = Describe("ClusterManager", func() {
@@ -42,35 +47,199 @@ var _ = Describe("ClusterManager", func() {
 				},
 			}
 
-			result := cm.getKubeconfigFromSecret(secret)
+			result, err := cm.getKubeconfigFromSecret(secret)
+			Expect(err).NotTo(HaveOccurred())
 			Expect(result).To(Equal([]byte("kubeconfig-content")))
 		})
 
-		It("should return nil if 'value' key is missing", func() {
+		It("should auto-detect well-known keys in order", func() {
 			secret := &corev1.Secret{
+				Data: map[string][]byte{
+					"config":     []byte("config-content"),
+					"kubeconfig": []byte("kubeconfig-content"),
+				},
+			}
+
+			result, err := cm.getKubeconfigFromSecret(secret)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal([]byte("kubeconfig-content")))
+		})
+
+		It("should skip empty well-known keys", func() {
+			secret := &corev1.Secret{
+				Data: map[string][]byte{
+					"value":  {},
+					"config": []byte("config-content"),
+				},
+			}
+
+			result, err := cm.getKubeconfigFromSecret(secret)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal([]byte("config-content")))
+		})
+
+		It("should use the key configured for the manager", func() {
+			cm.kubeconfigKey = "custom"
+			secret := &corev1.Secret{
+				Data: map[string][]byte{
+					"value":  []byte("value-content"),
+					"custom": []byte("custom-content"),
+				},
+			}
+
+			result, err := cm.getKubeconfigFromSecret(secret)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal([]byte("custom-content")))
+		})
+
+		It("should prefer the key from the secret annotation", func() {
+			cm.kubeconfigKey = "custom"
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{AnnotationKubeconfigKey: "admin.conf"},
+				},
+				Data: map[string][]byte{
+					"custom":     []byte("custom-content"),
+					"admin.conf": []byte("admin-content"),
+				},
+			}
+
+			result, err := cm.getKubeconfigFromSecret(secret)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal([]byte("admin-content")))
+		})
+
+		It("should not fall back to well-known keys when the key is configured", func() {
+			cm.kubeconfigKey = "custom"
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "kubeconfig", Namespace: "clusters"},
+				Data: map[string][]byte{
+					"value": []byte("value-content"),
+				},
+			}
+
+			_, err := cm.getKubeconfigFromSecret(secret)
+			Expect(err).To(MatchError("kubeconfig not found in secret clusters/kubeconfig, tried keys: custom"))
+		})
+
+		It("should list tried keys if no well-known key is present", func() {
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "kubeconfig", Namespace: "clusters"},
 				Data: map[string][]byte{
 					"other-key": []byte("some-content"),
 				},
 			}
 
-			result := cm.getKubeconfigFromSecret(secret)
-			Expect(result).To(BeNil())
+			_, err := cm.getKubeconfigFromSecret(secret)
+			Expect(err).To(MatchError(
+				"kubeconfig not found in secret clusters/kubeconfig, tried keys: value, kubeconfig, config, kubeconfig.yaml"))
 		})
 
-		It("should return nil for empty secret data", func() {
+		It("should return error for empty secret data", func() {
 			secret := &corev1.Secret{
 				Data: map[string][]byte{},
 			}
 
-			result := cm.getKubeconfigFromSecret(secret)
-			Expect(result).To(BeNil())
+			_, err := cm.getKubeconfigFromSecret(secret)
+			Expect(err).To(HaveOccurred())
 		})
 
-		It("should return nil for nil data", func() {
+		It("should return error for nil data", func() {
 			secret := &corev1.Secret{}
 
-			result := cm.getKubeconfigFromSecret(secret)
-			Expect(result).To(BeNil())
+			_, err := cm.getKubeconfigFromSecret(secret)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("Argo CD cluster secrets", func() {
+		newArgoCDSecret := func(config string) *corev1.Secret {
+			return &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cluster-workload",
+					Namespace: "argocd",
+					Labels:    map[string]string{LabelArgoCDSecretType: ArgoCDSecretTypeCluster},
+				},
+				Data: map[string][]byte{
+					"name":   []byte("workload"),
+					"server": []byte("https://workload.example.com:6443"),
+					"config": []byte(config),
+				},
+			}
+		}
+
+		It("should detect Argo CD cluster secrets by label", func() {
+			Expect(isArgoCDClusterSecret(newArgoCDSecret("{}"))).To(BeTrue())
+			Expect(isArgoCDClusterSecret(&corev1.Secret{})).To(BeFalse())
+		})
+
+		It("should build REST config with bearer token and TLS settings", func() {
+			secret := newArgoCDSecret(`{
+				"bearerToken": "token",
+				"tlsClientConfig": {"insecure": false, "serverName": "workload", "caData": "Y2EtZGF0YQ=="}
+			}`)
+
+			restConfig, err := restConfigFromArgoCDSecret(secret)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(restConfig.Host).To(Equal("https://workload.example.com:6443"))
+			Expect(restConfig.BearerToken).To(Equal("token"))
+			Expect(restConfig.TLSClientConfig.ServerName).To(Equal("workload"))
+			Expect(restConfig.TLSClientConfig.CAData).To(Equal([]byte("ca-data")))
+			Expect(restConfig.ExecProvider).To(BeNil())
+		})
+
+		It("should build REST config with exec provider", func() {
+			secret := newArgoCDSecret(`{
+				"execProviderConfig": {
+					"command": "kubelogin",
+					"args": ["get-token"],
+					"env": {"B": "2", "A": "1"},
+					"apiVersion": "client.authentication.k8s.io/v1beta1"
+				},
+				"tlsClientConfig": {"insecure": true}
+			}`)
+
+			restConfig, err := restConfigFromArgoCDSecret(secret)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(restConfig.Insecure).To(BeTrue())
+			Expect(restConfig.ExecProvider).NotTo(BeNil())
+			Expect(restConfig.ExecProvider.Command).To(Equal("kubelogin"))
+			Expect(restConfig.ExecProvider.Args).To(Equal([]string{"get-token"}))
+			Expect(restConfig.ExecProvider.Env).To(HaveLen(2))
+			Expect(restConfig.ExecProvider.Env[0].Name).To(Equal("A"))
+			Expect(restConfig.ExecProvider.APIVersion).To(Equal("client.authentication.k8s.io/v1beta1"))
+		})
+
+		It("should reject AWS auth config", func() {
+			secret := newArgoCDSecret(`{"awsAuthConfig": {"clusterName": "workload"}}`)
+
+			_, err := restConfigFromArgoCDSecret(secret)
+			Expect(err).To(MatchError(ContainSubstring("awsAuthConfig")))
+		})
+
+		It("should return error for invalid config JSON", func() {
+			_, err := restConfigFromArgoCDSecret(newArgoCDSecret("not-json"))
+			Expect(err).To(MatchError(ContainSubstring("failed to parse Argo CD cluster config")))
+		})
+
+		It("should change cache hash when server or config changes", func() {
+			secret := newArgoCDSecret(`{"bearerToken": "a"}`)
+			data1, err := getArgoCDClusterData(secret)
+			Expect(err).NotTo(HaveOccurred())
+
+			secret.Data["config"] = []byte(`{"bearerToken": "b"}`)
+			data2, err := getArgoCDClusterData(secret)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(data1).NotTo(Equal(data2))
+		})
+
+		It("should return error if server is missing", func() {
+			secret := newArgoCDSecret("{}")
+			delete(secret.Data, "server")
+
+			_, err := getArgoCDClusterData(secret)
+			Expect(err).To(MatchError(ContainSubstring("server not found")))
 		})
 	})
 
@@ -123,12 +292,13 @@ var _ = Describe("ClusterManager", func() {
 			ttl := 10 * time.Minute
 			maxConcurrent := 5
 
-			cm := NewClusterManager(ttl, scheme, maxConcurrent)
+			cm := NewClusterManager(ttl, scheme, maxConcurrent, "kubeconfig")
 
 			Expect(cm).NotTo(BeNil())
 			Expect(cm.ttl).To(Equal(ttl))
 			Expect(cm.scheme).To(Equal(scheme))
 			Expect(cm.maxConcurrentReconciles).To(Equal(maxConcurrent))
+			Expect(cm.kubeconfigKey).To(Equal("kubeconfig"))
 			Expect(cm.clients).NotTo(BeNil())
 		})
 	})
@@ -180,6 +350,194 @@ var _ = Describe("ClusterManager", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("failed to parse kubeconfig"))
 		})
+
+		It("should return error for invalid Argo CD cluster config", func() {
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cluster-workload",
+					Namespace: "argocd",
+					Labels:    map[string]string{LabelArgoCDSecretType: ArgoCDSecretTypeCluster},
+				},
+				Data: map[string][]byte{
+					"server": []byte("https://workload.example.com:6443"),
+					"config": []byte("not-json"),
+				},
+			}
+
+			_, err := cm.GetClient(secret)
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("failed to parse Argo CD cluster config"))
+		})
+	})
+
+	Describe("drift detection", func() {
+		var (
+			cm     *ClusterManager
+			ctx    context.Context
+			cancel context.CancelFunc
+			events <-chan event.GenericEvent
+			reader client.Client
+		)
+
+		newCopy := func(resourceVersion string) *corev1.Secret {
+			return &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "my-secret",
+					Namespace:       "target-ns",
+					ResourceVersion: resourceVersion,
+					Annotations: map[string]string{
+						AnnotationSourceSecret: "default/my-secret",
+					},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			ctx, cancel = context.WithCancel(context.Background())
+			cm = &ClusterManager{clients: make(map[string]*cachedClient)}
+			reader = fake.NewClientBuilder().Build()
+			cm.EnableDriftDetection(ctx, reader)
+			events = cm.DriftEvents(KindSecret)
+		})
+
+		AfterEach(func() {
+			cancel()
+		})
+
+		It("should initialize watch state", func() {
+			Expect(cm.watches).NotTo(BeNil())
+			Expect(events).NotTo(BeNil())
+		})
+
+		It("should report updated copies", func() {
+			cm.driftHandler(ctx).OnUpdate(newCopy("1"), newCopy("2"))
+
+			Expect(events).To(Receive(WithTransform(func(e event.GenericEvent) string {
+				return e.Object.GetName()
+			}, Equal("my-secret"))))
+		})
+
+		It("should report copies to the channel of their source kind", func() {
+			configMapEvents := cm.DriftEvents(KindConfigMap)
+			fromConfigMap := newCopy("2")
+			fromConfigMap.Annotations[AnnotationSourceKind] = KindConfigMap
+			cm.driftHandler(ctx).OnUpdate(newCopy("1"), fromConfigMap)
+
+			Expect(configMapEvents).To(Receive())
+			Expect(events).NotTo(Receive())
+		})
+
+		It("should drop copies of source kinds without a channel", func() {
+			fromConfigMap := newCopy("2")
+			fromConfigMap.Annotations[AnnotationSourceKind] = KindConfigMap
+			cm.driftHandler(ctx).OnUpdate(newCopy("1"), fromConfigMap)
+
+			Expect(events).NotTo(Receive())
+		})
+
+		It("should ignore informer resyncs", func() {
+			cm.driftHandler(ctx).OnUpdate(newCopy("1"), newCopy("1"))
+
+			Expect(events).NotTo(Receive())
+		})
+
+		It("should report deleted copies including tombstones", func() {
+			handler := cm.driftHandler(ctx)
+			handler.OnDelete(newCopy("1"))
+			handler.OnDelete(toolscache.DeletedFinalStateUnknown{Key: "target-ns/my-secret", Obj: newCopy("1")})
+
+			Expect(events).To(Receive())
+			Expect(events).To(Receive())
+		})
+
+		It("should ignore secrets without source annotation", func() {
+			secret := newCopy("2")
+			secret.Annotations = nil
+			cm.driftHandler(ctx).OnUpdate(newCopy("1"), secret)
+
+			Expect(events).NotTo(Receive())
+		})
+
+		It("should retry a missing watch for a cached client", func() {
+			cm.ttl = 5 * time.Minute
+			cm.scheme = runtime.NewScheme()
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cluster-workload",
+					Namespace: "argocd",
+					Labels:    map[string]string{LabelArgoCDSecretType: ArgoCDSecretTypeCluster},
+				},
+				Data: map[string][]byte{
+					"server": []byte("https://127.0.0.1:1"),
+					"config": []byte(`{"bearerToken": "token"}`),
+				},
+			}
+
+			cl, err := cm.GetClient(secret)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cm.watches).To(HaveKey("argocd/cluster-workload"))
+
+			// Simulate a watch that failed to start
+			cm.stopWatch("argocd/cluster-workload")
+
+			cached, err := cm.GetClient(secret)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cached).To(BeIdenticalTo(cl))
+			Expect(cm.watches).To(HaveKey("argocd/cluster-workload"))
+		})
+
+		It("should keep watches running when clients expire", func() {
+			cm.ttl = 5 * time.Minute
+			stopped := false
+			cm.clients["clusters/expired"] = &cachedClient{kubeconfigHash: "hash", createdAt: time.Now().Add(-time.Hour)}
+			cm.watches["clusters/expired"] = &clusterWatch{kubeconfigHash: "hash", cancel: func() { stopped = true }}
+			Expect(reader.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "expired", Namespace: "clusters"},
+			})).To(Succeed())
+
+			cm.evictExpired(time.Now())
+			cm.pruneWatches()
+
+			Expect(cm.clients).NotTo(HaveKey("clusters/expired"))
+			Expect(cm.watches).To(HaveKey("clusters/expired"))
+			Expect(stopped).To(BeFalse())
+		})
+
+		It("should stop watches of clusters whose kubeconfig secret is deleted", func() {
+			stopped := map[string]bool{}
+			watch := func(key string) *clusterWatch {
+				return &clusterWatch{kubeconfigHash: "hash", cancel: func() { stopped[key] = true }}
+			}
+			cm.watches["clusters/kept"] = watch("clusters/kept")
+			cm.watches["clusters/removed"] = watch("clusters/removed")
+			Expect(reader.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "kept", Namespace: "clusters"},
+			})).To(Succeed())
+
+			cm.pruneWatches()
+
+			Expect(cm.watches).To(HaveLen(1))
+			Expect(cm.watches).To(HaveKey("clusters/kept"))
+			Expect(stopped).To(Equal(map[string]bool{"clusters/removed": true}))
+		})
+
+	})
+
+	Describe("stripSecretData", func() {
+		It("should drop secret payload", func() {
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-secret"},
+				Data:       map[string][]byte{"key": []byte("value")},
+				StringData: map[string]string{"key": "value"},
+			}
+
+			result, err := stripSecretData(secret)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.(*corev1.Secret).Data).To(BeNil())
+			Expect(result.(*corev1.Secret).StringData).To(BeNil())
+			Expect(result.(*corev1.Secret).Name).To(Equal("my-secret"))
+		})
 	})
 
 })
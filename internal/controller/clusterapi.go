@@ -0,0 +1,192 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// clusterAPIKubeconfigSuffix is appended to the Cluster name by Cluster API for its kubeconfig secret
+	clusterAPIKubeconfigSuffix = "-kubeconfig"
+	// conditionControlPlaneReady is the Cluster API condition reported once the control plane is reachable
+	conditionControlPlaneReady = "ControlPlaneReady"
+	// clusterAPIPollPeriod is how often sources recheck clusters waiting for a control plane
+	// when the Cluster API watch is disabled
+	clusterAPIPollPeriod = time.Minute
+)
+
+// ClusterAPIClusterGVK is the Cluster API Cluster kind, read as unstructured to avoid the dependency
+var ClusterAPIClusterGVK = schema.GroupVersionKind{Group: "cluster.x-k8s.io", Version: "v1beta1", Kind: "Cluster"}
+
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters,verbs=get;list;watch
+
+// newClusterAPICluster returns an empty unstructured Cluster API Cluster
+func newClusterAPICluster() *unstructured.Unstructured {
+	cluster := &unstructured.Unstructured{}
+	cluster.SetGroupVersionKind(ClusterAPIClusterGVK)
+	return cluster
+}
+
+// clusterAPIKubeconfigRef returns the kubeconfig secret Cluster API creates for the cluster
+func clusterAPIKubeconfigRef(cluster types.NamespacedName) types.NamespacedName {
+	return types.NamespacedName{Namespace: cluster.Namespace, Name: cluster.Name + clusterAPIKubeconfigSuffix}
+}
+
+// clusterAPIClusterForKubeconfig returns the Cluster a kubeconfig secret may belong to by naming convention
+func clusterAPIClusterForKubeconfig(kubeconfigRef types.NamespacedName) (types.NamespacedName, bool) {
+	name, ok := strings.CutSuffix(kubeconfigRef.Name, clusterAPIKubeconfigSuffix)
+	if !ok || name == "" {
+		return types.NamespacedName{}, false
+	}
+	return types.NamespacedName{Namespace: kubeconfigRef.Namespace, Name: name}, true
+}
+
+// isControlPlaneReady returns true if the Cluster reports a ready control plane
+func isControlPlaneReady(cluster *unstructured.Unstructured) bool {
+	if ready, found, _ := unstructured.NestedBool(cluster.Object, "status", "controlPlaneReady"); found && ready {
+		return true
+	}
+
+	conditions, _, _ := unstructured.NestedSlice(cluster.Object, "status", "conditions")
+	for _, item := range conditions {
+		condition, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if condition["type"] == conditionControlPlaneReady {
+			return condition["status"] == "True"
+		}
+	}
+	return false
+}
+
+// resolveClusterAPIDestinations returns kubeconfig references of Cluster API clusters referenced by
+// dstCluster or selected by dstClusterAPISelector. Clusters whose control plane is not ready yet,
+// or which do not exist yet, are returned as pending.
+func (r *SecretCopyReconciler) resolveClusterAPIDestinations(
+	ctx context.Context,
+	config *CopyConfig,
+) (ready, pending []types.NamespacedName, err error) {
+	logger := log.FromContext(ctx)
+
+	for _, ref := range config.DstClusterRefs {
+		cluster := newClusterAPICluster()
+		if err := r.Get(ctx, ref, cluster); err != nil {
+			if !errors.IsNotFound(err) {
+				return nil, nil, fmt.Errorf("failed to get cluster %s: %w", ref, err)
+			}
+			logger.Info("Cluster API cluster not found, waiting", "cluster", ref)
+			pending = append(pending, clusterAPIKubeconfigRef(ref))
+			continue
+		}
+		if !isControlPlaneReady(cluster) {
+			logger.Info("Cluster API control plane is not ready, waiting", "cluster", ref)
+			pending = append(pending, clusterAPIKubeconfigRef(ref))
+			continue
+		}
+		ready = append(ready, clusterAPIKubeconfigRef(ref))
+	}
+
+	if config.DstClusterAPISelector == nil {
+		return ready, pending, nil
+	}
+
+	clusters := &unstructured.UnstructuredList{}
+	clusters.SetGroupVersionKind(ClusterAPIClusterGVK.GroupVersion().WithKind(ClusterAPIClusterGVK.Kind + "List"))
+	if err := r.List(ctx, clusters, client.MatchingLabelsSelector{Selector: config.DstClusterAPISelector}); err != nil {
+		return nil, nil, fmt.Errorf("failed to list Cluster API clusters: %w", err)
+	}
+
+	var selectedReady, selectedPending []types.NamespacedName
+	for i := range clusters.Items {
+		ref := clusterAPIKubeconfigRef(client.ObjectKeyFromObject(&clusters.Items[i]))
+		if isControlPlaneReady(&clusters.Items[i]) {
+			selectedReady = append(selectedReady, ref)
+		} else {
+			selectedPending = append(selectedPending, ref)
+		}
+	}
+	// List order is not guaranteed, keep status messages and logs stable
+	for _, refs := range [][]types.NamespacedName{selectedReady, selectedPending} {
+		sort.Slice(refs, func(i, j int) bool {
+			return refs[i].String() < refs[j].String()
+		})
+	}
+
+	return append(ready, selectedReady...), append(pending, selectedPending...), nil
+}
+
+// formatClusterAPIClusters formats Cluster references of the kubeconfig references for status messages
+func formatClusterAPIClusters(kubeconfigRefs []types.NamespacedName) string {
+	clusters := make([]string, 0, len(kubeconfigRefs))
+	for _, ref := range kubeconfigRefs {
+		if cluster, ok := clusterAPIClusterForKubeconfig(ref); ok {
+			clusters = append(clusters, cluster.String())
+		}
+	}
+	return strings.Join(clusters, ", ")
+}
+
+// clusterAPIClusterMatches returns true if the source config references or selects the Cluster
+func clusterAPIClusterMatches(config *CopyConfig, cluster types.NamespacedName, clusterLabels labels.Set) bool {
+	return slices.Contains(config.DstClusterRefs, cluster) ||
+		(config.DstClusterAPISelector != nil && config.DstClusterAPISelector.Matches(clusterLabels))
+}
+
+// findSourcesOfKindForClusterAPICluster maps a Cluster API Cluster to the sources of the list kind
+// that reference it with dstCluster or select it with dstClusterAPISelector
+func (r *SecretCopyReconciler) findSourcesOfKindForClusterAPICluster(
+	ctx context.Context,
+	sources client.ObjectList,
+	obj client.Object,
+) []reconcile.Request {
+	cluster := client.ObjectKeyFromObject(obj)
+	clusterLabels := labels.Set(obj.GetLabels())
+
+	destinations := []string{clusterAPIDestination(cluster), destinationClusterAPISelector}
+	return r.findSources(ctx, sources, destinations, func(_ client.Object, config *CopyConfig) bool {
+		return clusterAPIClusterMatches(config, cluster, clusterLabels)
+	})
+}
+
+// clusterAPIClusterChanged returns true if labels or control plane readiness of a Cluster changed
+func clusterAPIClusterChanged(oldObj, newObj client.Object) bool {
+	oldCluster, ok1 := oldObj.(*unstructured.Unstructured)
+	newCluster, ok2 := newObj.(*unstructured.Unstructured)
+	if !ok1 || !ok2 {
+		return true
+	}
+
+	return !reflect.DeepEqual(oldCluster.GetLabels(), newCluster.GetLabels()) ||
+		isControlPlaneReady(oldCluster) != isControlPlaneReady(newCluster)
+}
@@ -0,0 +1,528 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	secretcopyv1alpha1 "secret-copy-operator/api/v1alpha1"
+)
+
+// ClusterSecretCopyReconciler reconciles a ClusterSecretCopy object.
+// Every selected source secret is copied to every selected destination cluster
+// with the same copy logic as SecretCopyReconciler.
+type ClusterSecretCopyReconciler struct {
+	SecretCopyReconciler
+}
+
+// clusterCopyConfig contains parsed configuration of a ClusterSecretCopy
+type clusterCopyConfig struct {
+	*CopyConfig                       // shared settings, DstNamespace and DstSecretName are set per source
+	NamespaceSelector labels.Selector // nil means all namespaces
+	SecretSelector    labels.Selector
+}
+
+// clusterSyncTarget identifies a copy of one of the selected source secrets
+type clusterSyncTarget struct {
+	Source types.NamespacedName
+	syncTarget
+}
+
+// +kubebuilder:rbac:groups=secret-copy.in-cloud.io,resources=clustersecretcopies,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=secret-copy.in-cloud.io,resources=clustersecretcopies/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=secret-copy.in-cloud.io,resources=clustersecretcopies/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+
+func (r *ClusterSecretCopyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	clusterCopy := &secretcopyv1alpha1.ClusterSecretCopy{}
+	if err := r.Get(ctx, req.NamespacedName, clusterCopy); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !clusterCopy.DeletionTimestamp.IsZero() {
+		return r.reconcileClusterCleanup(ctx, clusterCopy)
+	}
+
+	config, err := configFromClusterSpec(clusterCopy)
+	if err != nil {
+		logger.Error(nil, "Invalid ClusterSecretCopy spec", "reason", err.Error())
+		return ctrl.Result{}, r.updateClusterStatus(ctx, clusterCopy, secretcopyv1alpha1.ReasonInvalidSpec, err.Error())
+	}
+
+	if err := r.ensureResourceFinalizer(ctx, clusterCopy, config.DeletionPolicy); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	sources, err := r.resolveSources(ctx, config)
+	if err != nil {
+		logger.Error(err, "Failed to resolve source secrets")
+		return r.clusterSyncFailed(ctx, clusterCopy, err.Error())
+	}
+
+	destinations, _, err := r.resolveDestinations(ctx, config.CopyConfig)
+	if err != nil {
+		logger.Error(err, "Failed to resolve destination clusters")
+		return r.clusterSyncFailed(ctx, clusterCopy, err.Error())
+	}
+
+	logger.Info("Reconciling ClusterSecretCopy",
+		"sources", len(sources),
+		"dstKubeconfigs", destinations,
+	)
+
+	clusterCopy.Status.SourceCount = int32(len(sources))
+	clusterCopy.Status.ClusterCount = int32(len(destinations))
+
+	previous := clusterSyncTargetsFromStatus(clusterCopy.Status.SyncedTargets)
+	desired := make([]clusterSyncTarget, 0, len(sources)*len(destinations))
+	synced := make([]clusterSyncTarget, 0, len(sources)*len(destinations))
+
+	var syncErrors, invalidSources []string
+	for i := range sources {
+		source := &sources[i]
+		sourceKey := client.ObjectKeyFromObject(source)
+		srcConfig := sourceConfig(clusterCopy, config, source)
+
+		// Retrying does not help a source whose copy is invalid, its existing copies are kept
+		_, invalid := r.prepareContent(source, srcConfig)
+		if invalid != nil {
+			invalidSources = append(invalidSources, fmt.Sprintf("%s: %s", sourceKey, invalid.Error()))
+		}
+
+		for _, ref := range destinations {
+			target := clusterSyncTarget{Source: sourceKey, syncTarget: newSyncTarget(ref, srcConfig)}
+			desired = append(desired, target)
+
+			if invalid != nil {
+				if slices.Contains(previous, target) {
+					synced = append(synced, target)
+				}
+				continue
+			}
+
+			if err := r.syncToCluster(ctx, source, ref, srcConfig); err != nil {
+				syncErrors = append(syncErrors, fmt.Sprintf("%s -> %s: %s", sourceKey, target.syncTarget, err.Error()))
+				if slices.Contains(previous, target) {
+					synced = append(synced, target)
+				}
+				continue
+			}
+			synced = append(synced, target)
+		}
+	}
+
+	// Copies of secrets or clusters that no longer match the selectors
+	for _, target := range previous {
+		if slices.Contains(desired, target) {
+			continue
+		}
+		if config.DeletionPolicy != DeletionPolicyDelete {
+			logger.Info("Forgetting stale copy", "source", target.Source, "target", target.syncTarget.String(),
+				"deletionPolicy", config.DeletionPolicy)
+			continue
+		}
+		if err := r.deleteTarget(ctx, stubSource(target.Source), target.syncTarget); err != nil {
+			syncErrors = append(syncErrors, fmt.Sprintf("%s -> %s: failed to prune stale copy: %s",
+				target.Source, target.syncTarget, err.Error()))
+			synced = append(synced, target)
+		}
+	}
+
+	clusterCopy.Status.SyncedTargets = clusterSyncTargetsToStatus(synced)
+
+	if len(syncErrors) > 0 {
+		return r.clusterSyncFailed(ctx, clusterCopy, strings.Join(append(invalidSources, syncErrors...), "; "))
+	}
+
+	if len(invalidSources) > 0 {
+		logger.Info("Source secrets cannot be copied", "sources", len(invalidSources))
+		return ctrl.Result{}, r.updateClusterStatus(ctx, clusterCopy, secretcopyv1alpha1.ReasonInvalidSpec,
+			strings.Join(invalidSources, "; "))
+	}
+
+	if len(sources) == 0 || len(destinations) == 0 {
+		// Secret, namespace and kubeconfig watches enqueue us when something starts matching
+		message := "no source secrets match selectors"
+		if len(destinations) == 0 {
+			message = "no destination clusters match selector"
+		}
+		logger.Info("Nothing to copy", "reason", message)
+		return ctrl.Result{}, r.updateClusterStatus(ctx, clusterCopy, secretcopyv1alpha1.ReasonNoMatch, message)
+	}
+
+	if err := r.updateClusterStatus(ctx, clusterCopy, secretcopyv1alpha1.ReasonSynced,
+		fmt.Sprintf("copied %d secret(s) to %d cluster(s)", len(sources), len(destinations))); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: r.ResyncPeriod}, nil
+}
+
+// clusterSyncFailed records a failed sync and schedules a retry with exponential backoff
+func (r *ClusterSecretCopyReconciler) clusterSyncFailed(
+	ctx context.Context,
+	clusterCopy *secretcopyv1alpha1.ClusterSecretCopy,
+	message string,
+) (ctrl.Result, error) {
+	delay := calculateBackoff(int(clusterCopy.Status.RetryCount))
+	if err := r.updateClusterStatus(ctx, clusterCopy, secretcopyv1alpha1.ReasonSyncFailed, message); err != nil {
+		return ctrl.Result{}, err
+	}
+	log.FromContext(ctx).Info("Scheduling retry", "delay", delay)
+	return ctrl.Result{RequeueAfter: delay}, nil
+}
+
+// reconcileClusterCleanup removes copies when deletionPolicy=Delete and releases the ClusterSecretCopy
+func (r *ClusterSecretCopyReconciler) reconcileClusterCleanup(
+	ctx context.Context,
+	clusterCopy *secretcopyv1alpha1.ClusterSecretCopy,
+) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	if !controllerutil.ContainsFinalizer(clusterCopy, FinalizerCleanup) {
+		return ctrl.Result{}, nil
+	}
+
+	config, err := configFromClusterSpec(clusterCopy)
+	if err != nil {
+		// Policy is unknown without a valid spec, never delete copies blindly
+		logger.Error(nil, "Invalid ClusterSecretCopy spec, orphaning copies", "reason", err.Error())
+		return ctrl.Result{}, r.removeResourceFinalizer(ctx, clusterCopy)
+	}
+
+	if config.DeletionPolicy == DeletionPolicyDelete {
+		var remaining []clusterSyncTarget
+		var deleteErrors []string
+		for _, target := range clusterSyncTargetsFromStatus(clusterCopy.Status.SyncedTargets) {
+			if err := r.deleteTarget(ctx, stubSource(target.Source), target.syncTarget); err != nil {
+				deleteErrors = append(deleteErrors, fmt.Sprintf("%s -> %s: %s", target.Source, target.syncTarget, err.Error()))
+				remaining = append(remaining, target)
+			}
+		}
+
+		if len(deleteErrors) > 0 {
+			clusterCopy.Status.SyncedTargets = clusterSyncTargetsToStatus(remaining)
+			return r.clusterSyncFailed(ctx, clusterCopy, strings.Join(deleteErrors, "; "))
+		}
+	}
+
+	logger.Info("Releasing ClusterSecretCopy", "deletionPolicy", config.DeletionPolicy)
+	return ctrl.Result{}, r.removeResourceFinalizer(ctx, clusterCopy)
+}
+
+// updateClusterStatus sets the Ready condition from reason and writes the ClusterSecretCopy status
+func (r *ClusterSecretCopyReconciler) updateClusterStatus(
+	ctx context.Context,
+	clusterCopy *secretcopyv1alpha1.ClusterSecretCopy,
+	reason, message string,
+) error {
+	now := metav1.Now()
+	clusterCopy.Status.ObservedGeneration = clusterCopy.Generation
+	clusterCopy.Status.LastSyncTime = &now
+	setReadyCondition(&clusterCopy.Status.Conditions, &clusterCopy.Status.RetryCount, clusterCopy.Generation, reason, message)
+
+	if err := r.Status().Update(ctx, clusterCopy); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to update ClusterSecretCopy status: %w", err)
+	}
+	return nil
+}
+
+// resolveSources returns secrets matching the secret and namespace selectors sorted by namespace/name.
+// Copies written by the operator into this cluster are never used as sources.
+func (r *ClusterSecretCopyReconciler) resolveSources(ctx context.Context, config *clusterCopyConfig) ([]corev1.Secret, error) {
+	var namespaces map[string]bool
+	if config.NamespaceSelector != nil {
+		namespaceList := &corev1.NamespaceList{}
+		if err := r.List(ctx, namespaceList, client.MatchingLabelsSelector{Selector: config.NamespaceSelector}); err != nil {
+			return nil, fmt.Errorf("failed to list source namespaces: %w", err)
+		}
+		namespaces = make(map[string]bool, len(namespaceList.Items))
+		for _, ns := range namespaceList.Items {
+			namespaces[ns.Name] = true
+		}
+	}
+
+	secrets := &corev1.SecretList{}
+	if err := r.List(ctx, secrets, client.MatchingLabelsSelector{Selector: config.SecretSelector}); err != nil {
+		return nil, fmt.Errorf("failed to list source secrets: %w", err)
+	}
+
+	sources := make([]corev1.Secret, 0, len(secrets.Items))
+	for _, secret := range secrets.Items {
+		if namespaces != nil && !namespaces[secret.Namespace] {
+			continue
+		}
+		if secret.Labels[LabelCopy] == "true" {
+			continue
+		}
+		sources = append(sources, secret)
+	}
+	// List order is not guaranteed, keep status messages and logs stable
+	slices.SortFunc(sources, func(a, b corev1.Secret) int {
+		return strings.Compare(a.Namespace+"/"+a.Name, b.Namespace+"/"+b.Name)
+	})
+
+	return sources, nil
+}
+
+// configFromClusterSpec converts a ClusterSecretCopy spec into copy configuration
+func configFromClusterSpec(clusterCopy *secretcopyv1alpha1.ClusterSecretCopy) (*clusterCopyConfig, error) {
+	var namespaceSelector labels.Selector
+	if clusterCopy.Spec.SourceNamespaceSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(clusterCopy.Spec.SourceNamespaceSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid spec.sourceNamespaceSelector: %w", err)
+		}
+		namespaceSelector = selector
+	}
+
+	secretSelector, err := metav1.LabelSelectorAsSelector(&clusterCopy.Spec.SecretSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid spec.secretSelector: %w", err)
+	}
+	if secretSelector.Empty() {
+		return nil, fmt.Errorf("invalid spec.secretSelector: selector must not be empty")
+	}
+
+	clusterSelector, err := metav1.LabelSelectorAsSelector(&clusterCopy.Spec.DestinationClusterSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid spec.destinationClusterSelector: %w", err)
+	}
+	if clusterSelector.Empty() {
+		return nil, fmt.Errorf("invalid spec.destinationClusterSelector: selector must not be empty")
+	}
+
+	strategy, err := ParseStrategy(clusterCopy.Spec.Strategy)
+	if err != nil {
+		return nil, err
+	}
+
+	deletionPolicy, err := ParseDeletionPolicy(clusterCopy.Spec.DeletionPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clusterCopyConfig{
+		CopyConfig: &CopyConfig{
+			DstClusterSelector: clusterSelector,
+			DstNamespace:       clusterCopy.Spec.DestinationNamespace,
+			DstType:            clusterCopy.Spec.Type,
+			Strategy:           strategy,
+			DeletionPolicy:     deletionPolicy,
+			OwnerAnnotations: map[string]string{
+				AnnotationClusterSecretCopy: clusterCopy.Name,
+			},
+		},
+		NamespaceSelector: namespaceSelector,
+		SecretSelector:    secretSelector,
+	}, nil
+}
+
+// sourceConfig returns the configuration for copying a single selected source secret
+func sourceConfig(
+	clusterCopy *secretcopyv1alpha1.ClusterSecretCopy,
+	config *clusterCopyConfig,
+	source *corev1.Secret,
+) *CopyConfig {
+	srcConfig := *config.CopyConfig
+	srcConfig.DstSecretName = source.Name
+	srcConfig.DstNamespace = clusterCopy.Spec.DestinationNamespace
+	if srcConfig.DstNamespace == "" {
+		srcConfig.DstNamespace = source.Namespace
+	}
+	return &srcConfig
+}
+
+// stubSource returns a secret carrying only the source reference,
+// enough to recognize copies of a source that may no longer exist
+func stubSource(key types.NamespacedName) *corev1.Secret {
+	return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name}}
+}
+
+// clusterSyncTargetsFromStatus converts status targets into sync targets, malformed entries are skipped
+func clusterSyncTargetsFromStatus(targets []secretcopyv1alpha1.ClusterSyncedTarget) []clusterSyncTarget {
+	result := make([]clusterSyncTarget, 0, len(targets))
+	for _, t := range targets {
+		parts := strings.SplitN(t.Source, "/", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		result = append(result, clusterSyncTarget{
+			Source:     types.NamespacedName{Namespace: parts[0], Name: parts[1]},
+			syncTarget: syncTarget{Cluster: t.Cluster, Namespace: t.Namespace, Name: t.Name},
+		})
+	}
+	return result
+}
+
+// clusterSyncTargetsToStatus converts sync targets into status targets sorted for stable output
+func clusterSyncTargetsToStatus(targets []clusterSyncTarget) []secretcopyv1alpha1.ClusterSyncedTarget {
+	if len(targets) == 0 {
+		return nil
+	}
+	sorted := slices.Clone(targets)
+	slices.SortFunc(sorted, func(a, b clusterSyncTarget) int {
+		if c := strings.Compare(a.Source.String(), b.Source.String()); c != 0 {
+			return c
+		}
+		return strings.Compare(a.syncTarget.String(), b.syncTarget.String())
+	})
+
+	result := make([]secretcopyv1alpha1.ClusterSyncedTarget, 0, len(sorted))
+	for _, t := range sorted {
+		result = append(result, secretcopyv1alpha1.ClusterSyncedTarget{
+			Source: t.Source.String(),
+			SyncedTarget: secretcopyv1alpha1.SyncedTarget{
+				Cluster:   t.Cluster,
+				Namespace: t.Namespace,
+				Name:      t.Name,
+			},
+		})
+	}
+	return result
+}
+
+// findClusterSecretCopiesForSecret maps a secret to the ClusterSecretCopy resources
+// that select it as a source or as the kubeconfig of a destination cluster
+func (r *ClusterSecretCopyReconciler) findClusterSecretCopiesForSecret(ctx context.Context, obj client.Object) []reconcile.Request {
+	logger := log.FromContext(ctx)
+
+	clusterCopies := &secretcopyv1alpha1.ClusterSecretCopyList{}
+	if err := r.List(ctx, clusterCopies); err != nil {
+		logger.Error(err, "Failed to list ClusterSecretCopy resources for secret", "secret", client.ObjectKeyFromObject(obj))
+		return nil
+	}
+
+	secretLabels := labels.Set(obj.GetLabels())
+	var namespaceLabels labels.Set
+
+	var requests []reconcile.Request
+	for i := range clusterCopies.Items {
+		config, err := configFromClusterSpec(&clusterCopies.Items[i])
+		if err != nil {
+			continue
+		}
+
+		matches := config.DstClusterSelector.Matches(secretLabels)
+		if !matches && config.SecretSelector.Matches(secretLabels) {
+			matches = true
+			if config.NamespaceSelector != nil {
+				if namespaceLabels == nil {
+					namespaceLabels = r.namespaceLabels(ctx, obj.GetNamespace())
+				}
+				matches = config.NamespaceSelector.Matches(namespaceLabels)
+			}
+		}
+		if matches {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&clusterCopies.Items[i])})
+		}
+	}
+
+	return requests
+}
+
+// namespaceLabels returns labels of the namespace, empty if it cannot be read
+func (r *ClusterSecretCopyReconciler) namespaceLabels(ctx context.Context, name string) labels.Set {
+	ns := &corev1.Namespace{}
+	if err := r.Get(ctx, types.NamespacedName{Name: name}, ns); err != nil {
+		return labels.Set{}
+	}
+	return labels.Set(ns.Labels)
+}
+
+// findClusterSecretCopiesForNamespace maps a namespace to the ClusterSecretCopy resources
+// that select source namespaces by labels
+func (r *ClusterSecretCopyReconciler) findClusterSecretCopiesForNamespace(ctx context.Context, obj client.Object) []reconcile.Request {
+	logger := log.FromContext(ctx)
+
+	clusterCopies := &secretcopyv1alpha1.ClusterSecretCopyList{}
+	if err := r.List(ctx, clusterCopies); err != nil {
+		logger.Error(err, "Failed to list ClusterSecretCopy resources for namespace", "namespace", obj.GetName())
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for i := range clusterCopies.Items {
+		if clusterCopies.Items[i].Spec.SourceNamespaceSelector != nil {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&clusterCopies.Items[i])})
+		}
+	}
+
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager
+func (r *ClusterSecretCopyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		// Status updates do not bump generation, so they do not retrigger reconcile
+		For(&secretcopyv1alpha1.ClusterSecretCopy{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		// Source and kubeconfig secrets: both old and new labels are mapped,
+		// so secrets that stop matching the selectors are pruned as well
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.findClusterSecretCopiesForSecret),
+			builder.WithPredicates(predicate.Funcs{
+				UpdateFunc: func(e event.UpdateEvent) bool {
+					return sourceSpecChanged(e.ObjectOld, e.ObjectNew)
+				},
+				GenericFunc: func(e event.GenericEvent) bool {
+					return false
+				},
+			})).
+		// Relabeled namespaces may start or stop matching sourceNamespaceSelector
+		Watches(&corev1.Namespace{}, handler.EnqueueRequestsFromMapFunc(r.findClusterSecretCopiesForNamespace),
+			builder.WithPredicates(predicate.Funcs{
+				CreateFunc: func(e event.CreateEvent) bool {
+					return false
+				},
+				UpdateFunc: func(e event.UpdateEvent) bool {
+					return !reflect.DeepEqual(e.ObjectOld.GetLabels(), e.ObjectNew.GetLabels())
+				},
+				DeleteFunc: func(e event.DeleteEvent) bool {
+					return false
+				},
+				GenericFunc: func(e event.GenericEvent) bool {
+					return false
+				},
+			})).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: r.MaxConcurrentReconciles,
+		}).
+		Complete(r)
+}
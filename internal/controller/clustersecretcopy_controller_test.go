@@ -0,0 +1,422 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	secretcopyv1alpha1 "secret-copy-operator/api/v1alpha1"
+	"secret-copy-operator/test/mocks"
+)
+
+var _ = Describe("ClusterSecretCopyReconciler", func() {
+	var scheme *runtime.Scheme
+
+	newClusterSecretCopy := func() *secretcopyv1alpha1.ClusterSecretCopy {
+		return &secretcopyv1alpha1.ClusterSecretCopy{
+			ObjectMeta: metav1.ObjectMeta{Name: "registry", Generation: 1},
+			Spec: secretcopyv1alpha1.ClusterSecretCopySpec{
+				SourceNamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"platform": "true"},
+				},
+				SecretSelector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"distribute": "true"},
+				},
+				DestinationClusterSelector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"env": "prod"},
+				},
+			},
+		}
+	}
+
+	newSecret := func(namespace, name string, lbls map[string]string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: lbls},
+			Data:       map[string][]byte{"key": []byte(namespace + "/" + name)},
+		}
+	}
+
+	newNamespace := func(name string, lbls map[string]string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: lbls}}
+	}
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(secretcopyv1alpha1.AddToScheme(scheme)).To(Succeed())
+	})
+
+	Describe("configFromClusterSpec", func() {
+		It("should parse selectors and defaults", func() {
+			config, err := configFromClusterSpec(newClusterSecretCopy())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.NamespaceSelector.String()).To(Equal("platform=true"))
+			Expect(config.SecretSelector.String()).To(Equal("distribute=true"))
+			Expect(config.DstClusterSelector.String()).To(Equal("env=prod"))
+			Expect(config.Strategy).To(Equal(StrategyOverwrite))
+			Expect(config.DeletionPolicy).To(Equal(DeletionPolicyOrphan))
+			Expect(config.OwnerAnnotations).To(HaveKeyWithValue(AnnotationClusterSecretCopy, "registry"))
+		})
+
+		It("should select all namespaces without namespace selector", func() {
+			clusterCopy := newClusterSecretCopy()
+			clusterCopy.Spec.SourceNamespaceSelector = nil
+
+			config, err := configFromClusterSpec(clusterCopy)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.NamespaceSelector).To(BeNil())
+		})
+
+		It("should return error for empty secret selector", func() {
+			clusterCopy := newClusterSecretCopy()
+			clusterCopy.Spec.SecretSelector = metav1.LabelSelector{}
+
+			_, err := configFromClusterSpec(clusterCopy)
+			Expect(err).To(MatchError(ContainSubstring("spec.secretSelector")))
+		})
+
+		It("should return error for empty destination cluster selector", func() {
+			clusterCopy := newClusterSecretCopy()
+			clusterCopy.Spec.DestinationClusterSelector = metav1.LabelSelector{}
+
+			_, err := configFromClusterSpec(clusterCopy)
+			Expect(err).To(MatchError(ContainSubstring("spec.destinationClusterSelector")))
+		})
+
+		It("should return error for invalid selector operator", func() {
+			clusterCopy := newClusterSecretCopy()
+			clusterCopy.Spec.SecretSelector.MatchExpressions = []metav1.LabelSelectorRequirement{
+				{Key: "tier", Operator: "Like", Values: []string{"x"}},
+			}
+
+			_, err := configFromClusterSpec(clusterCopy)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("resolveSources", func() {
+		It("should return matching secrets from matching namespaces only", func() {
+			reconciler := &ClusterSecretCopyReconciler{SecretCopyReconciler: SecretCopyReconciler{
+				Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+					newNamespace("team-a", map[string]string{"platform": "true"}),
+					newNamespace("team-b", nil),
+					newSecret("team-a", "pull-secret", map[string]string{"distribute": "true"}),
+					newSecret("team-a", "other", nil),
+					newSecret("team-b", "pull-secret", map[string]string{"distribute": "true"}),
+					newSecret("team-a", "copied", map[string]string{"distribute": "true", LabelCopy: "true"}),
+				).Build(),
+			}}
+
+			config, err := configFromClusterSpec(newClusterSecretCopy())
+			Expect(err).NotTo(HaveOccurred())
+
+			sources, err := reconciler.resolveSources(context.Background(), config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sources).To(HaveLen(1))
+			Expect(client.ObjectKeyFromObject(&sources[0])).To(Equal(types.NamespacedName{Namespace: "team-a", Name: "pull-secret"}))
+		})
+	})
+
+	Describe("Reconcile", func() {
+		var (
+			ctx               context.Context
+			mockCtrl          *gomock.Controller
+			mockClusterGetter *mocks.MockClusterClientGetter
+			request           ctrl.Request
+		)
+
+		newReconciler := func(c client.Client) *ClusterSecretCopyReconciler {
+			return &ClusterSecretCopyReconciler{SecretCopyReconciler: SecretCopyReconciler{
+				Client:              c,
+				Scheme:              scheme,
+				ClusterClientGetter: mockClusterGetter,
+				ClusterName:         "management",
+			}}
+		}
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			mockCtrl = gomock.NewController(GinkgoT())
+			mockClusterGetter = mocks.NewMockClusterClientGetter(mockCtrl)
+			request = ctrl.Request{NamespacedName: types.NamespacedName{Name: "registry"}}
+		})
+
+		AfterEach(func() {
+			mockCtrl.Finish()
+		})
+
+		It("should copy every selected secret to every selected cluster", func() {
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(
+					newClusterSecretCopy(),
+					newNamespace("team-a", map[string]string{"platform": "true"}),
+					newNamespace("team-b", map[string]string{"platform": "true"}),
+					newSecret("team-a", "pull-secret", map[string]string{"distribute": "true"}),
+					newSecret("team-b", "ca-bundle", map[string]string{"distribute": "true"}),
+					newSecret("clusters", "prod-1", map[string]string{"env": "prod"}),
+					newSecret("clusters", "prod-2", map[string]string{"env": "prod"}),
+					newSecret("clusters", "dev-1", map[string]string{"env": "dev"}),
+				).
+				WithStatusSubresource(&secretcopyv1alpha1.ClusterSecretCopy{}).
+				Build()
+
+			targetClients := map[string]client.Client{}
+			for _, name := range []string{"prod-1", "prod-2"} {
+				targetClients[name] = fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(newNamespace("team-a", nil), newNamespace("team-b", nil)).
+					Build()
+			}
+			mockClusterGetter.EXPECT().GetClient(gomock.Any()).DoAndReturn(func(kubeconfig *corev1.Secret) (client.Client, error) {
+				return targetClients[kubeconfig.Name], nil
+			}).Times(4)
+
+			_, err := newReconciler(fakeClient).Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			for _, targetClient := range targetClients {
+				for _, key := range []types.NamespacedName{
+					{Namespace: "team-a", Name: "pull-secret"},
+					{Namespace: "team-b", Name: "ca-bundle"},
+				} {
+					copied := &corev1.Secret{}
+					Expect(targetClient.Get(ctx, key, copied)).To(Succeed())
+					Expect(copied.Data["key"]).To(Equal([]byte(key.String())))
+					Expect(copied.Annotations).To(HaveKeyWithValue(AnnotationClusterSecretCopy, "registry"))
+					Expect(copied.Annotations).To(HaveKeyWithValue(AnnotationSourceSecret, key.String()))
+				}
+			}
+
+			updated := &secretcopyv1alpha1.ClusterSecretCopy{}
+			Expect(fakeClient.Get(ctx, request.NamespacedName, updated)).To(Succeed())
+			Expect(updated.Status.SourceCount).To(Equal(int32(2)))
+			Expect(updated.Status.ClusterCount).To(Equal(int32(2)))
+			Expect(updated.Status.SyncedTargets).To(HaveLen(4))
+			Expect(updated.Status.SyncedTargets[0]).To(Equal(secretcopyv1alpha1.ClusterSyncedTarget{
+				Source: "team-a/pull-secret",
+				SyncedTarget: secretcopyv1alpha1.SyncedTarget{
+					Cluster: "clusters/prod-1", Namespace: "team-a", Name: "pull-secret",
+				},
+			}))
+			Expect(meta.IsStatusConditionTrue(updated.Status.Conditions, secretcopyv1alpha1.ConditionReady)).To(BeTrue())
+		})
+
+		It("should copy into destinationNamespace when set", func() {
+			clusterCopy := newClusterSecretCopy()
+			clusterCopy.Spec.DestinationNamespace = "shared"
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(
+					clusterCopy,
+					newNamespace("team-a", map[string]string{"platform": "true"}),
+					newSecret("team-a", "pull-secret", map[string]string{"distribute": "true"}),
+					newSecret("clusters", "prod-1", map[string]string{"env": "prod"}),
+				).
+				WithStatusSubresource(&secretcopyv1alpha1.ClusterSecretCopy{}).
+				Build()
+			targetClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(newNamespace("shared", nil)).Build()
+			mockClusterGetter.EXPECT().GetClient(gomock.Any()).Return(targetClient, nil)
+
+			_, err := newReconciler(fakeClient).Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(targetClient.Get(ctx, types.NamespacedName{Namespace: "shared", Name: "pull-secret"}, &corev1.Secret{})).To(Succeed())
+		})
+
+		It("should skip sources that cannot satisfy the destination type and report InvalidSpec", func() {
+			clusterCopy := newClusterSecretCopy()
+			clusterCopy.Spec.Type = corev1.SecretTypeBasicAuth
+			valid := newSecret("team-a", "registry-login", map[string]string{"distribute": "true"})
+			valid.Data = map[string][]byte{"username": []byte("robot")}
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(
+					clusterCopy,
+					newNamespace("team-a", map[string]string{"platform": "true"}),
+					newSecret("team-a", "pull-secret", map[string]string{"distribute": "true"}),
+					valid,
+					newSecret("clusters", "prod-1", map[string]string{"env": "prod"}),
+				).
+				WithStatusSubresource(&secretcopyv1alpha1.ClusterSecretCopy{}).
+				Build()
+			targetClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(newNamespace("team-a", nil)).Build()
+			mockClusterGetter.EXPECT().GetClient(gomock.Any()).Return(targetClient, nil)
+
+			result, err := newReconciler(fakeClient).Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeZero())
+
+			Expect(targetClient.Get(ctx, types.NamespacedName{Namespace: "team-a", Name: "registry-login"}, &corev1.Secret{})).To(Succeed())
+			err = targetClient.Get(ctx, types.NamespacedName{Namespace: "team-a", Name: "pull-secret"}, &corev1.Secret{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			updated := &secretcopyv1alpha1.ClusterSecretCopy{}
+			Expect(fakeClient.Get(ctx, request.NamespacedName, updated)).To(Succeed())
+			condition := meta.FindStatusCondition(updated.Status.Conditions, secretcopyv1alpha1.ConditionReady)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Reason).To(Equal(secretcopyv1alpha1.ReasonInvalidSpec))
+			Expect(condition.Message).To(ContainSubstring("team-a/pull-secret: secret type kubernetes.io/basic-auth requires key username or password"))
+		})
+
+		It("should report NoMatch without requeue when no cluster matches", func() {
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(
+					newClusterSecretCopy(),
+					newNamespace("team-a", map[string]string{"platform": "true"}),
+					newSecret("team-a", "pull-secret", map[string]string{"distribute": "true"}),
+				).
+				WithStatusSubresource(&secretcopyv1alpha1.ClusterSecretCopy{}).
+				Build()
+
+			result, err := newReconciler(fakeClient).Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeZero())
+
+			updated := &secretcopyv1alpha1.ClusterSecretCopy{}
+			Expect(fakeClient.Get(ctx, request.NamespacedName, updated)).To(Succeed())
+			condition := meta.FindStatusCondition(updated.Status.Conditions, secretcopyv1alpha1.ConditionReady)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Reason).To(Equal(secretcopyv1alpha1.ReasonNoMatch))
+			Expect(condition.Message).To(ContainSubstring("no destination clusters"))
+		})
+
+		It("should prune copies of secrets that no longer match with deletionPolicy=Delete", func() {
+			clusterCopy := newClusterSecretCopy()
+			clusterCopy.Spec.DeletionPolicy = string(DeletionPolicyDelete)
+			clusterCopy.Status.SyncedTargets = []secretcopyv1alpha1.ClusterSyncedTarget{{
+				Source: "team-a/old-secret",
+				SyncedTarget: secretcopyv1alpha1.SyncedTarget{
+					Cluster: "clusters/prod-1", Namespace: "team-a", Name: "old-secret",
+				},
+			}}
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(
+					clusterCopy,
+					newNamespace("team-a", map[string]string{"platform": "true"}),
+					newSecret("team-a", "pull-secret", map[string]string{"distribute": "true"}),
+					newSecret("team-a", "old-secret", nil),
+					newSecret("clusters", "prod-1", map[string]string{"env": "prod"}),
+				).
+				WithStatusSubresource(&secretcopyv1alpha1.ClusterSecretCopy{}).
+				Build()
+			staleCopy := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+				Name:      "old-secret",
+				Namespace: "team-a",
+				Annotations: map[string]string{
+					AnnotationSourceCluster: "management",
+					AnnotationSourceSecret:  "team-a/old-secret",
+				},
+			}}
+			targetClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(newNamespace("team-a", nil), staleCopy).Build()
+			mockClusterGetter.EXPECT().GetClient(gomock.Any()).Return(targetClient, nil).Times(2)
+
+			_, err := newReconciler(fakeClient).Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = targetClient.Get(ctx, client.ObjectKeyFromObject(staleCopy), &corev1.Secret{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			updated := &secretcopyv1alpha1.ClusterSecretCopy{}
+			Expect(fakeClient.Get(ctx, request.NamespacedName, updated)).To(Succeed())
+			Expect(updated.Finalizers).To(ContainElement(FinalizerCleanup))
+			Expect(updated.Status.SyncedTargets).To(HaveLen(1))
+			Expect(updated.Status.SyncedTargets[0].Source).To(Equal("team-a/pull-secret"))
+		})
+
+		It("should delete recorded copies on deletion with deletionPolicy=Delete", func() {
+			now := metav1.Now()
+			clusterCopy := newClusterSecretCopy()
+			clusterCopy.Spec.DeletionPolicy = string(DeletionPolicyDelete)
+			clusterCopy.Finalizers = []string{FinalizerCleanup}
+			clusterCopy.DeletionTimestamp = &now
+			clusterCopy.Status.SyncedTargets = []secretcopyv1alpha1.ClusterSyncedTarget{{
+				Source: "team-a/pull-secret",
+				SyncedTarget: secretcopyv1alpha1.SyncedTarget{
+					Cluster: "clusters/prod-1", Namespace: "team-a", Name: "pull-secret",
+				},
+			}}
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(clusterCopy, newSecret("clusters", "prod-1", map[string]string{"env": "prod"})).
+				WithStatusSubresource(&secretcopyv1alpha1.ClusterSecretCopy{}).
+				Build()
+			copied := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+				Name:      "pull-secret",
+				Namespace: "team-a",
+				Annotations: map[string]string{
+					AnnotationSourceCluster: "management",
+					AnnotationSourceSecret:  "team-a/pull-secret",
+				},
+			}}
+			targetClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(copied).Build()
+			mockClusterGetter.EXPECT().GetClient(gomock.Any()).Return(targetClient, nil)
+
+			_, err := newReconciler(fakeClient).Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = targetClient.Get(ctx, client.ObjectKeyFromObject(copied), &corev1.Secret{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			err = fakeClient.Get(ctx, request.NamespacedName, &secretcopyv1alpha1.ClusterSecretCopy{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Describe("findClusterSecretCopiesForSecret", func() {
+		It("should map selected source and kubeconfig secrets", func() {
+			other := newClusterSecretCopy()
+			other.Name = "other"
+			other.Spec.SecretSelector.MatchLabels = map[string]string{"distribute": "other"}
+			other.Spec.DestinationClusterSelector.MatchLabels = map[string]string{"env": "dev"}
+
+			reconciler := &ClusterSecretCopyReconciler{SecretCopyReconciler: SecretCopyReconciler{
+				Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+					newClusterSecretCopy(),
+					other,
+					newNamespace("team-a", map[string]string{"platform": "true"}),
+					newNamespace("team-b", nil),
+				).Build(),
+			}}
+
+			expected := []reconcile.Request{{NamespacedName: types.NamespacedName{Name: "registry"}}}
+			Expect(reconciler.findClusterSecretCopiesForSecret(context.Background(),
+				newSecret("team-a", "pull-secret", map[string]string{"distribute": "true"}))).To(Equal(expected))
+			Expect(reconciler.findClusterSecretCopiesForSecret(context.Background(),
+				newSecret("clusters", "prod-1", map[string]string{"env": "prod"}))).To(Equal(expected))
+			Expect(reconciler.findClusterSecretCopiesForSecret(context.Background(),
+				newSecret("team-b", "pull-secret", map[string]string{"distribute": "true"}))).To(BeEmpty())
+		})
+	})
+})
@@ -17,72 +17,416 @@ limitations under the License.
 package controller
 
 import (
+	"errors"
 	"fmt"
+	"slices"
+	"sort"
 	"strings"
+	"text/template"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-// CopyConfig contains parsed configuration from secret annotations
+// CopyConfig contains parsed configuration from secret annotations or a SecretCopy resource
 type CopyConfig struct {
-	DstKubeconfigRef types.NamespacedName
-	DstNamespace     string
-	DstSecretName    string
-	DstType          corev1.SecretType // empty means use source type
-	Strategy         Strategy
-	FieldsMapping    map[string]string // srcKey -> dstKey
+	DstKubeconfigRefs     []types.NamespacedName
+	DstClusterSelector    labels.Selector        // nil means no selector-based destinations
+	DstClusterRefs        []types.NamespacedName // Cluster API Clusters
+	DstClusterAPISelector labels.Selector        // nil means no selector-based Cluster API destinations
+	DstNamespace          string                 // namespace of the copy being written, the first listed namespace
+	DstNamespaces         []string               // all listed namespaces, empty means DstNamespace only
+	DstNamespaceSelector  labels.Selector        // nil means no selector-based namespaces
+	CreateNamespace       bool                   // create missing destination namespaces
+	NamespaceLabels       map[string]string      // labels of created namespaces
+	NamespaceAnnotations  map[string]string      // annotations of created namespaces
+	DstSecretName         string
+	DstType               corev1.SecretType // empty means use source type
+	DstKind               string            // empty means the source kind
+	Transform             Transform         // empty means no transformation
+	AllowSensitiveKeys    bool              // allow private keys and credentials in ConfigMap copies of a Secret
+	Strategy              Strategy
+	FieldsMapping         map[string]string             // srcKey -> dstKey
+	IncludeKeys           []keyPattern                  // empty means all keys unless FieldsMapping is set
+	ExcludeKeys           []keyPattern                  // matching keys are not copied unless mapped in FieldsMapping
+	RenameKeys            []renameRule                  // applied to keys without FieldsMapping, first match wins
+	DataTemplates         map[string]*template.Template // dstKey -> template over the source data
+	DeletionPolicy        DeletionPolicy
+	ResyncPeriod          *time.Duration    // nil means use operator default
+	OwnerAnnotations      map[string]string // mark copies with the managing resource, nil in annotation mode
 }
 
-// parseConfig extracts copy configuration from secret annotations
-func parseConfig(secret *corev1.Secret) (*CopyConfig, error) {
-	annotations := secret.Annotations
+// dstNameData is the data of dstName templates
+type dstNameData struct {
+	SourceNamespace string
+	SourceName      string
+}
+
+// renderDstName renders the dstName annotation value for the source and validates the result.
+// Returns the source name if value is empty.
+func renderDstName(value string, source client.Object) (string, error) {
+	if value == "" {
+		return source.GetName(), nil
+	}
+
+	tmpl, err := template.New("dstName").Option("missingkey=error").Parse(value)
+	if err != nil {
+		return "", fmt.Errorf("invalid %s template: %w", AnnotationDstName, err)
+	}
+	var name strings.Builder
+	if err := tmpl.Execute(&name, dstNameData{
+		SourceNamespace: source.GetNamespace(),
+		SourceName:      source.GetName(),
+	}); err != nil {
+		return "", fmt.Errorf("invalid %s template: %w", AnnotationDstName, err)
+	}
+
+	if msgs := validation.IsDNS1123Subdomain(name.String()); len(msgs) > 0 {
+		return "", fmt.Errorf("invalid %s %q: %s", AnnotationDstName, name.String(), strings.Join(msgs, ", "))
+	}
+	return name.String(), nil
+}
+
+// parseConfig extracts copy configuration from annotations of a source Secret or ConfigMap
+func parseConfig(source client.Object) (*CopyConfig, error) {
+	annotations := source.GetAnnotations()
 	if annotations == nil {
 		return nil, fmt.Errorf("no annotations found")
 	}
 
-	// Parse dstClusterKubeconfig: "namespace/secret-name"
-	kubeconfigRef := annotations[AnnotationDstKubeconfig]
-	if kubeconfigRef == "" {
-		return nil, fmt.Errorf("annotation %s is required", AnnotationDstKubeconfig)
+	config := &CopyConfig{
+		CreateNamespace:    annotations[AnnotationCreateNamespace] == "true",
+		DstType:            corev1.SecretType(annotations[AnnotationDstType]),
+		AllowSensitiveKeys: annotations[AnnotationAllowSensitiveKeys] == "true",
 	}
-
-	parts := strings.SplitN(kubeconfigRef, "/", 2)
-	if len(parts) != 2 {
-		return nil, fmt.Errorf("invalid %s format, expected 'namespace/name'", AnnotationDstKubeconfig)
+	if err := parseDestinations(config, source, annotations); err != nil {
+		return nil, err
+	}
+	if err := parseKeySelection(config, annotations); err != nil {
+		return nil, err
+	}
+	if err := parseConversion(config, source, annotations); err != nil {
+		return nil, err
 	}
 
-	// Parse dstNamespace
-	dstNamespace := annotations[AnnotationDstNamespace]
-	if dstNamespace == "" {
-		dstNamespace = secret.Namespace // default to same namespace
+	// A copy of the same kind and name into the source namespace of the same cluster would overwrite the source itself
+	if slices.Contains(config.DstKubeconfigRefs, inClusterRef) && slices.Contains(config.DstNamespaces, source.GetNamespace()) &&
+		config.DstSecretName == source.GetName() && config.DstKind == "" {
+		return nil, fmt.Errorf("%s %q requires %s or %s different from the source",
+			AnnotationDstKubeconfig, InClusterDestination, AnnotationDstNamespace, AnnotationDstName)
 	}
 
 	strategy, err := ParseStrategy(annotations[AnnotationStrategyIfExist])
 	if err != nil {
 		return nil, err
 	}
+	config.Strategy = strategy
+
+	deletionPolicy, err := ParseDeletionPolicy(annotations[AnnotationDeletionPolicy])
+	if err != nil {
+		return nil, err
+	}
+	config.DeletionPolicy = deletionPolicy
+
+	if value := annotations[AnnotationResyncPeriod]; value != "" {
+		period, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", AnnotationResyncPeriod, err)
+		}
+		if period < 0 {
+			return nil, fmt.Errorf("invalid %s: must not be negative", AnnotationResyncPeriod)
+		}
+		config.ResyncPeriod = &period
+	}
+
+	return config, nil
+}
+
+// parseDestinations fills destination clusters, namespaces and the copy name of config from annotations
+func parseDestinations(config *CopyConfig, source client.Object, annotations map[string]string) error {
+	// Without destination annotations the copy is made in the cluster the operator runs in
+	if annotations[AnnotationDstKubeconfig] == "" && annotations[AnnotationDstClusterSelector] == "" &&
+		annotations[AnnotationDstCluster] == "" && annotations[AnnotationDstClusterAPISelector] == "" {
+		config.DstKubeconfigRefs = []types.NamespacedName{inClusterRef}
+	}
+
+	// Parse dstClusterKubeconfig: comma-separated list of "namespace/secret-name" or "in-cluster"
+	if annotations[AnnotationDstKubeconfig] != "" {
+		refs, err := parseRefs(AnnotationDstKubeconfig, annotations[AnnotationDstKubeconfig], true)
+		if err != nil {
+			return err
+		}
+		config.DstKubeconfigRefs = refs
+	}
+
+	// Parse dstClusterSelector: label selector for kubeconfig secrets
+	clusterSelector, err := parseSelector(AnnotationDstClusterSelector, annotations[AnnotationDstClusterSelector])
+	if err != nil {
+		return err
+	}
+	config.DstClusterSelector = clusterSelector
+
+	// Parse dstCluster: comma-separated list of Cluster API "namespace/cluster-name"
+	if annotations[AnnotationDstCluster] != "" {
+		refs, err := parseRefs(AnnotationDstCluster, annotations[AnnotationDstCluster], false)
+		if err != nil {
+			return err
+		}
+		config.DstClusterRefs = refs
+	}
+
+	// Parse dstClusterAPISelector: label selector for Cluster API Clusters
+	clusterAPISelector, err := parseSelector(AnnotationDstClusterAPISelector, annotations[AnnotationDstClusterAPISelector])
+	if err != nil {
+		return err
+	}
+	config.DstClusterAPISelector = clusterAPISelector
+
+	// Parse dstNamespace: comma-separated list of namespaces
+	var dstNamespaces []string
+	for _, item := range strings.Split(annotations[AnnotationDstNamespace], ",") {
+		if item = strings.TrimSpace(item); item != "" && !slices.Contains(dstNamespaces, item) {
+			dstNamespaces = append(dstNamespaces, item)
+		}
+	}
+
+	// Parse dstNamespaceSelector: label selector for namespaces in destination clusters
+	namespaceSelector, err := parseSelector(AnnotationDstNamespaceSelector, annotations[AnnotationDstNamespaceSelector])
+	if err != nil {
+		return err
+	}
+	if len(dstNamespaces) == 0 && namespaceSelector == nil {
+		dstNamespaces = []string{source.GetNamespace()} // default to same namespace
+	}
+	if len(dstNamespaces) > 0 {
+		config.DstNamespace = dstNamespaces[0]
+	}
+	config.DstNamespaces = dstNamespaces
+	config.DstNamespaceSelector = namespaceSelector
 
-	fieldsMapping := make(map[string]string)
+	config.NamespaceLabels, err = parseKeyValues(AnnotationNamespaceLabels, annotations[AnnotationNamespaceLabels], true)
+	if err != nil {
+		return err
+	}
+	config.NamespaceAnnotations, err = parseKeyValues(AnnotationNamespaceAnnotations, annotations[AnnotationNamespaceAnnotations], false)
+	if err != nil {
+		return err
+	}
+
+	config.DstSecretName, err = renderDstName(annotations[AnnotationDstName], source)
+	return err
+}
+
+// parseKeySelection fills field mappings and key patterns of config from annotations
+func parseKeySelection(config *CopyConfig, annotations map[string]string) error {
+	config.FieldsMapping = make(map[string]string)
 	for key, value := range annotations {
 		if strings.HasPrefix(key, AnnotationFieldsPrefix) {
 			srcKey := strings.TrimPrefix(key, AnnotationFieldsPrefix)
 			dstKey := value
 			if srcKey != "" && dstKey != "" {
-				fieldsMapping[srcKey] = dstKey
+				config.FieldsMapping[srcKey] = dstKey
+			}
+		}
+	}
+
+	var err error
+	if config.IncludeKeys, err = parseKeyPatterns(AnnotationIncludeKeys, annotations[AnnotationIncludeKeys]); err != nil {
+		return err
+	}
+	if config.ExcludeKeys, err = parseKeyPatterns(AnnotationExcludeKeys, annotations[AnnotationExcludeKeys]); err != nil {
+		return err
+	}
+	config.RenameKeys, err = parseRenameRules(AnnotationRenameKeys, annotations[AnnotationRenameKeys])
+	return err
+}
+
+// parseConversion fills the destination kind, transform and data templates of config from annotations
+func parseConversion(config *CopyConfig, source client.Object, annotations map[string]string) error {
+	dstKind, err := ParseKind(annotations[AnnotationDstKind])
+	if err != nil {
+		return err
+	}
+	if dstKind != sourceKind(source) {
+		// Targets recorded without a kind refer to copies of the source kind
+		config.DstKind = dstKind
+	}
+
+	transform, err := ParseTransform(annotations[AnnotationTransform])
+	if err != nil {
+		return err
+	}
+	if transform != "" {
+		if kind := destinationKind(source, config); kind != KindSecret {
+			return fmt.Errorf("%s %q requires Secret copies, got %s", AnnotationTransform, transform, kind)
+		}
+		if config.DstType != "" && !slices.Contains(transform.secretTypes(), config.DstType) {
+			return fmt.Errorf("%s %q does not produce the keys of %s %q, expected one of %v",
+				AnnotationTransform, transform, AnnotationDstType, config.DstType, transform.secretTypes())
+		}
+	}
+	config.Transform = transform
+
+	config.DataTemplates, err = parseDataTemplates(annotations)
+	return err
+}
+
+// ValidateConfig checks copy configuration in secret annotations for admission.
+// In addition to parseConfig it rejects field mappings with empty or colliding keys
+// and invalid destination namespace or type, which Reconcile tolerates, and sensitive keys
+// converted into a ConfigMap.
+func ValidateConfig(secret *corev1.Secret) error {
+	config, err := parseConfig(secret)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, namespace := range config.DstNamespaces {
+		if msgs := validation.IsDNS1123Label(namespace); len(msgs) > 0 {
+			errs = append(errs, fmt.Errorf("invalid %s %q: %s", AnnotationDstNamespace, namespace, strings.Join(msgs, ", ")))
+		}
+	}
+	if value := secret.Annotations[AnnotationDstType]; value != "" && len(validation.IsQualifiedName(value)) > 0 {
+		errs = append(errs, fmt.Errorf("invalid %s %q", AnnotationDstType, value))
+	}
+	if err := checkSensitiveKeys(secret, config); err != nil {
+		errs = append(errs, err)
+	}
+
+	// Walk mappings in a stable order so that collisions are reported the same way every time
+	keys := make([]string, 0, len(secret.Annotations))
+	for key := range secret.Annotations {
+		if strings.HasPrefix(key, AnnotationFieldsPrefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	srcKeys := make(map[string]string)
+	for _, key := range keys {
+		value := secret.Annotations[key]
+		srcKey := strings.TrimPrefix(key, AnnotationFieldsPrefix)
+		switch {
+		case srcKey == "":
+			errs = append(errs, fmt.Errorf("invalid field mapping %q: source key is empty", key))
+		case value == "":
+			errs = append(errs, fmt.Errorf("invalid field mapping %q: destination key is empty", key))
+		case srcKeys[value] != "":
+			errs = append(errs, fmt.Errorf("invalid field mapping %q: destination key %q is also mapped from %q",
+				key, value, srcKeys[value]))
+		default:
+			srcKeys[value] = srcKey
+		}
+	}
+
+	// A data template replaces the value of a mapped key, which is most likely a mistake
+	for dstKey := range srcKeys {
+		if _, ok := config.DataTemplates[dstKey]; ok {
+			errs = append(errs, fmt.Errorf("invalid data template %q: destination key %q is also mapped from %q",
+				AnnotationTemplatePrefix+dstKey, dstKey, srcKeys[dstKey]))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// listedNamespaces returns the destination namespaces that are known without asking the destination cluster
+func (c *CopyConfig) listedNamespaces() []string {
+	if len(c.DstNamespaces) > 0 {
+		return c.DstNamespaces
+	}
+	if c.DstNamespace != "" {
+		return []string{c.DstNamespace}
+	}
+	return nil
+}
+
+// forNamespace returns a shallow copy of the configuration writing into namespace
+func (c *CopyConfig) forNamespace(namespace string) *CopyConfig {
+	nsConfig := *c
+	nsConfig.DstNamespace = namespace
+	return &nsConfig
+}
+
+// parseRefs parses a comma-separated list of "namespace/name" references from the annotation.
+// Empty items are skipped, duplicates are removed preserving order. If allowInCluster is set,
+// InClusterDestination is accepted and returned as inClusterRef.
+func parseRefs(annotation, value string, allowInCluster bool) ([]types.NamespacedName, error) {
+	var refs []types.NamespacedName
+	seen := make(map[types.NamespacedName]bool)
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		var ref types.NamespacedName
+		if allowInCluster && item == InClusterDestination {
+			ref = inClusterRef
+		} else {
+			parts := strings.SplitN(item, "/", 2)
+			if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+				return nil, fmt.Errorf("invalid %s format %q, expected 'namespace/name'", annotation, item)
 			}
+			ref = types.NamespacedName{Namespace: parts[0], Name: parts[1]}
+		}
+		if seen[ref] {
+			continue
 		}
+		seen[ref] = true
+		refs = append(refs, ref)
 	}
 
-	return &CopyConfig{
-		DstKubeconfigRef: types.NamespacedName{
-			Namespace: parts[0],
-			Name:      parts[1],
-		},
-		DstNamespace:  dstNamespace,
-		DstSecretName: secret.Name,
-		DstType:       corev1.SecretType(annotations[AnnotationDstType]),
-		Strategy:      strategy,
-		FieldsMapping: fieldsMapping,
-	}, nil
+	if len(refs) == 0 {
+		return nil, fmt.Errorf("annotation %s is required", annotation)
+	}
+	return refs, nil
+}
+
+// parseKeyValues parses comma-separated key=value pairs from the annotation, empty value means nil.
+// Keys must be qualified names, values of labels must be valid label values.
+func parseKeyValues(annotation, value string, label bool) (map[string]string, error) {
+	if value == "" {
+		return nil, nil
+	}
+	result := make(map[string]string)
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		key, val, ok := strings.Cut(item, "=")
+		key, val = strings.TrimSpace(key), strings.TrimSpace(val)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid %s format %q, expected 'key=value'", annotation, item)
+		}
+		if msgs := validation.IsQualifiedName(key); len(msgs) > 0 {
+			return nil, fmt.Errorf("invalid %s key %q: %s", annotation, key, strings.Join(msgs, ", "))
+		}
+		if msgs := validation.IsValidLabelValue(val); label && len(msgs) > 0 {
+			return nil, fmt.Errorf("invalid %s value %q: %s", annotation, val, strings.Join(msgs, ", "))
+		}
+		result[key] = val
+	}
+	return result, nil
+}
+
+// parseSelector parses a non-empty label selector from the annotation, empty value means no selector
+func parseSelector(annotation, value string) (labels.Selector, error) {
+	if value == "" {
+		return nil, nil
+	}
+	selector, err := labels.Parse(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", annotation, err)
+	}
+	if selector.Empty() {
+		return nil, fmt.Errorf("invalid %s: selector must not be empty", annotation)
+	}
+	return selector, nil
 }
@@ -0,0 +1,45 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ConfigMapCopyReconciler reconciles a ConfigMap object.
+// It shares the copy logic and the label/annotation contract with SecretCopyReconciler,
+// both data and binaryData are copied.
+type ConfigMapCopyReconciler struct {
+	SecretCopyReconciler
+}
+
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch;delete
+
+func (r *ConfigMapCopyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.reconcileSource(ctx, req, &corev1.ConfigMap{})
+}
+
+// SetupWithManager sets up the controller with the Manager
+func (r *ConfigMapCopyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return r.setupSourceController(mgr, &corev1.ConfigMap{}, func() client.ObjectList {
+		return &corev1.ConfigMapList{}
+	}, r)
+}
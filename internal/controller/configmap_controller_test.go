@@ -0,0 +1,370 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"secret-copy-operator/test/mocks"
+)
+
+var _ = Describe("ConfigMapCopyReconciler", func() {
+	var (
+		ctx               context.Context
+		scheme            *runtime.Scheme
+		fakeClient        client.Client
+		fakeTargetClient  client.Client
+		mockCtrl          *gomock.Controller
+		mockClusterGetter *mocks.MockClusterClientGetter
+		reconciler        *ConfigMapCopyReconciler
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme = runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+
+		mockCtrl = gomock.NewController(GinkgoT())
+		mockClusterGetter = mocks.NewMockClusterClientGetter(mockCtrl)
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	kubeconfigSecret := func() *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "target-kubeconfig", Namespace: "kube-system"},
+			Data:       map[string][]byte{"value": []byte("kubeconfig-data")},
+		}
+	}
+
+	newReconciler := func() *ConfigMapCopyReconciler {
+		return &ConfigMapCopyReconciler{
+			SecretCopyReconciler: SecretCopyReconciler{
+				Client:              fakeClient,
+				Scheme:              scheme,
+				ClusterClientGetter: mockClusterGetter,
+				ClusterName:         "management",
+			},
+		}
+	}
+
+	reconcileSource := func() (ctrl.Result, error) {
+		return reconciler.Reconcile(ctx, ctrl.Request{
+			NamespacedName: types.NamespacedName{Name: "ca-bundle", Namespace: "default"},
+		})
+	}
+
+	It("should copy data and binaryData to the target cluster", func() {
+		source := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ca-bundle",
+				Namespace: "default",
+				Labels:    map[string]string{LabelEnabled: "true", "app": "platform"},
+				Annotations: map[string]string{
+					AnnotationDstKubeconfig: "kube-system/target-kubeconfig",
+					AnnotationDstNamespace:  "target-ns",
+					"team":                  "platform",
+				},
+			},
+			Data:       map[string]string{"ca.crt": "-----BEGIN CERTIFICATE-----"},
+			BinaryData: map[string][]byte{"truststore.jks": {0xfe, 0xed}},
+		}
+
+		fakeClient = fake.NewClientBuilder().WithScheme(scheme).WithObjects(source, kubeconfigSecret()).Build()
+		fakeTargetClient = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "target-ns"}}).
+			Build()
+		mockClusterGetter.EXPECT().GetClient(gomock.Any()).Return(fakeTargetClient, nil)
+		reconciler = newReconciler()
+
+		_, err := reconcileSource()
+		Expect(err).NotTo(HaveOccurred())
+
+		copied := &corev1.ConfigMap{}
+		Expect(fakeTargetClient.Get(ctx, types.NamespacedName{Name: "ca-bundle", Namespace: "target-ns"}, copied)).To(Succeed())
+		Expect(copied.Data).To(Equal(map[string]string{"ca.crt": "-----BEGIN CERTIFICATE-----"}))
+		Expect(copied.BinaryData).To(Equal(map[string][]byte{"truststore.jks": {0xfe, 0xed}}))
+		Expect(copied.Labels).To(HaveKeyWithValue(LabelCopy, "true"))
+		Expect(copied.Labels).To(HaveKeyWithValue("app", "platform"))
+		Expect(copied.Labels).NotTo(HaveKey(LabelEnabled))
+		Expect(copied.Annotations).To(HaveKeyWithValue("team", "platform"))
+		Expect(copied.Annotations).To(HaveKeyWithValue(AnnotationSourceSecret, "default/ca-bundle"))
+		Expect(copied.Annotations).To(HaveKeyWithValue(AnnotationSourceCluster, "management"))
+		Expect(copied.Annotations).NotTo(HaveKey(AnnotationDstKubeconfig))
+
+		updated := &corev1.ConfigMap{}
+		Expect(fakeClient.Get(ctx, types.NamespacedName{Name: "ca-bundle", Namespace: "default"}, updated)).To(Succeed())
+		Expect(updated.Annotations[AnnotationLastSyncStatus]).To(Equal(StatusSynced))
+		Expect(getSyncedTargets(updated)).To(Equal([]syncTarget{
+			{Cluster: "kube-system/target-kubeconfig", Namespace: "target-ns", Name: "ca-bundle"},
+		}))
+	})
+
+	It("should apply field mapping to data and binaryData", func() {
+		source := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ca-bundle",
+				Namespace: "default",
+				Labels:    map[string]string{LabelEnabled: "true"},
+				Annotations: map[string]string{
+					AnnotationDstKubeconfig:                   "kube-system/target-kubeconfig",
+					AnnotationFieldsPrefix + "ca.crt":         "root-ca.pem",
+					AnnotationFieldsPrefix + "truststore.jks": "truststore",
+				},
+			},
+			Data:       map[string]string{"ca.crt": "ca", "extra": "dropped"},
+			BinaryData: map[string][]byte{"truststore.jks": {0x01}},
+		}
+
+		fakeClient = fake.NewClientBuilder().WithScheme(scheme).WithObjects(source, kubeconfigSecret()).Build()
+		fakeTargetClient = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}}).
+			Build()
+		mockClusterGetter.EXPECT().GetClient(gomock.Any()).Return(fakeTargetClient, nil)
+		reconciler = newReconciler()
+
+		_, err := reconcileSource()
+		Expect(err).NotTo(HaveOccurred())
+
+		copied := &corev1.ConfigMap{}
+		Expect(fakeTargetClient.Get(ctx, types.NamespacedName{Name: "ca-bundle", Namespace: "default"}, copied)).To(Succeed())
+		Expect(copied.Data).To(Equal(map[string]string{"root-ca.pem": "ca"}))
+		Expect(copied.BinaryData).To(Equal(map[string][]byte{"truststore": {0x01}}))
+	})
+
+	It("should convert ConfigMap into a secret", func() {
+		source := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ca-bundle",
+				Namespace: "default",
+				Labels:    map[string]string{LabelEnabled: "true"},
+				Annotations: map[string]string{
+					AnnotationDstKubeconfig: "kube-system/target-kubeconfig",
+					AnnotationDstKind:       KindSecret,
+				},
+			},
+			Data:       map[string]string{"ca.crt": "ca"},
+			BinaryData: map[string][]byte{"truststore.jks": {0xfe, 0xed}},
+		}
+
+		fakeClient = fake.NewClientBuilder().WithScheme(scheme).WithObjects(source, kubeconfigSecret()).Build()
+		fakeTargetClient = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}}).
+			Build()
+		mockClusterGetter.EXPECT().GetClient(gomock.Any()).Return(fakeTargetClient, nil)
+		reconciler = newReconciler()
+
+		_, err := reconcileSource()
+		Expect(err).NotTo(HaveOccurred())
+
+		copied := &corev1.Secret{}
+		Expect(fakeTargetClient.Get(ctx, types.NamespacedName{Name: "ca-bundle", Namespace: "default"}, copied)).To(Succeed())
+		Expect(copied.Type).To(Equal(corev1.SecretTypeOpaque))
+		Expect(copied.Data).To(Equal(map[string][]byte{
+			"ca.crt":         []byte("ca"),
+			"truststore.jks": {0xfe, 0xed},
+		}))
+		Expect(copied.Annotations).To(HaveKeyWithValue(AnnotationSourceSecret, "default/ca-bundle"))
+		// Drift on the copy is routed to the ConfigMap controller
+		Expect(copied.Annotations).To(HaveKeyWithValue(AnnotationSourceKind, KindConfigMap))
+
+		updated := &corev1.ConfigMap{}
+		Expect(fakeClient.Get(ctx, types.NamespacedName{Name: "ca-bundle", Namespace: "default"}, updated)).To(Succeed())
+		Expect(getSyncedTargets(updated)).To(Equal([]syncTarget{
+			{Cluster: "kube-system/target-kubeconfig", Namespace: "default", Name: "ca-bundle", Kind: KindSecret},
+		}))
+	})
+
+	It("should render data templates over data and binaryData", func() {
+		source := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ca-bundle",
+				Namespace: "default",
+				Labels:    map[string]string{LabelEnabled: "true"},
+				Annotations: map[string]string{
+					AnnotationDstKubeconfig:                 "kube-system/target-kubeconfig",
+					AnnotationTemplatePrefix + "bundle.pem": "{{ .Data.root }}\n{{ .Data.intermediate }}",
+				},
+			},
+			Data:       map[string]string{"root": "ROOT"},
+			BinaryData: map[string][]byte{"intermediate": []byte("INTERMEDIATE")},
+		}
+
+		fakeClient = fake.NewClientBuilder().WithScheme(scheme).WithObjects(source, kubeconfigSecret()).Build()
+		fakeTargetClient = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}}).
+			Build()
+		mockClusterGetter.EXPECT().GetClient(gomock.Any()).Return(fakeTargetClient, nil)
+		reconciler = newReconciler()
+
+		_, err := reconcileSource()
+		Expect(err).NotTo(HaveOccurred())
+
+		copied := &corev1.ConfigMap{}
+		Expect(fakeTargetClient.Get(ctx, types.NamespacedName{Name: "ca-bundle", Namespace: "default"}, copied)).To(Succeed())
+		Expect(copied.Data).To(Equal(map[string]string{"root": "ROOT", "bundle.pem": "ROOT\nINTERMEDIATE"}))
+		Expect(copied.BinaryData).To(Equal(map[string][]byte{"intermediate": []byte("INTERMEDIATE")}))
+	})
+
+	It("should keep existing ConfigMap with strategy=ignore", func() {
+		source := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ca-bundle",
+				Namespace: "default",
+				Labels:    map[string]string{LabelEnabled: "true"},
+				Annotations: map[string]string{
+					AnnotationDstKubeconfig:   "kube-system/target-kubeconfig",
+					AnnotationStrategyIfExist: string(StrategyIgnore),
+				},
+			},
+			Data: map[string]string{"ca.crt": "new"},
+		}
+		existing := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "ca-bundle", Namespace: "default"},
+			Data:       map[string]string{"ca.crt": "old"},
+		}
+
+		fakeClient = fake.NewClientBuilder().WithScheme(scheme).WithObjects(source, kubeconfigSecret()).Build()
+		fakeTargetClient = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}}, existing).
+			Build()
+		mockClusterGetter.EXPECT().GetClient(gomock.Any()).Return(fakeTargetClient, nil)
+		reconciler = newReconciler()
+
+		_, err := reconcileSource()
+		Expect(err).NotTo(HaveOccurred())
+
+		kept := &corev1.ConfigMap{}
+		Expect(fakeTargetClient.Get(ctx, types.NamespacedName{Name: "ca-bundle", Namespace: "default"}, kept)).To(Succeed())
+		Expect(kept.Data).To(Equal(map[string]string{"ca.crt": "old"}))
+	})
+
+	It("should record an error status and retry when the kubeconfig is missing", func() {
+		source := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ca-bundle",
+				Namespace: "default",
+				Labels:    map[string]string{LabelEnabled: "true"},
+				Annotations: map[string]string{
+					AnnotationDstKubeconfig: "kube-system/missing",
+				},
+			},
+			Data: map[string]string{"ca.crt": "ca"},
+		}
+
+		fakeClient = fake.NewClientBuilder().WithScheme(scheme).WithObjects(source).Build()
+		reconciler = newReconciler()
+
+		result, err := reconcileSource()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(baseRetryDelay))
+
+		updated := &corev1.ConfigMap{}
+		Expect(fakeClient.Get(ctx, types.NamespacedName{Name: "ca-bundle", Namespace: "default"}, updated)).To(Succeed())
+		Expect(updated.Annotations[AnnotationLastSyncStatus]).To(HavePrefix(StatusErrorPrefix))
+		Expect(updated.Annotations[AnnotationRetryCount]).To(Equal("1"))
+	})
+
+	It("should delete copies of a deleted ConfigMap with deletionPolicy=Delete", func() {
+		source := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "ca-bundle",
+				Namespace:  "default",
+				Labels:     map[string]string{LabelEnabled: "true"},
+				Finalizers: []string{FinalizerCleanup},
+				Annotations: map[string]string{
+					AnnotationDstKubeconfig:  "kube-system/target-kubeconfig",
+					AnnotationDeletionPolicy: string(DeletionPolicyDelete),
+					AnnotationSyncedTargets:  `[{"cluster":"kube-system/target-kubeconfig","namespace":"default","name":"ca-bundle"}]`,
+				},
+			},
+		}
+		copied := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ca-bundle",
+				Namespace: "default",
+				Annotations: map[string]string{
+					AnnotationSourceSecret:  "default/ca-bundle",
+					AnnotationSourceCluster: "management",
+				},
+			},
+		}
+
+		fakeClient = fake.NewClientBuilder().WithScheme(scheme).WithObjects(source, kubeconfigSecret()).Build()
+		Expect(fakeClient.Delete(ctx, source)).To(Succeed())
+		fakeTargetClient = fake.NewClientBuilder().WithScheme(scheme).WithObjects(copied).Build()
+		mockClusterGetter.EXPECT().GetClient(gomock.Any()).Return(fakeTargetClient, nil)
+		reconciler = newReconciler()
+
+		_, err := reconcileSource()
+		Expect(err).NotTo(HaveOccurred())
+
+		err = fakeTargetClient.Get(ctx, types.NamespacedName{Name: "ca-bundle", Namespace: "default"}, &corev1.ConfigMap{})
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+		err = fakeClient.Get(ctx, types.NamespacedName{Name: "ca-bundle", Namespace: "default"}, &corev1.ConfigMap{})
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should enqueue ConfigMap sources referencing a kubeconfig", func() {
+		fakeClient = fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithIndex(&corev1.ConfigMap{}, sourceDestinationsIndex, indexSourceDestinations).
+			WithObjects(
+				&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+					Name:        "ca-bundle",
+					Namespace:   "default",
+					Labels:      map[string]string{LabelEnabled: "true"},
+					Annotations: map[string]string{AnnotationDstKubeconfig: "kube-system/target-kubeconfig"},
+				}},
+				// Secret sources belong to the Secret controller
+				&corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+					Name:        "my-secret",
+					Namespace:   "default",
+					Labels:      map[string]string{LabelEnabled: "true"},
+					Annotations: map[string]string{AnnotationDstKubeconfig: "kube-system/target-kubeconfig"},
+				}},
+			).
+			Build()
+		reconciler = newReconciler()
+
+		requests := reconciler.findSourcesOfKindForKubeconfig(ctx, &corev1.ConfigMapList{}, kubeconfigSecret())
+		Expect(requests).To(ConsistOf(
+			reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "ca-bundle"}},
+		))
+	})
+})
@@ -19,22 +19,90 @@ package controller
 const (
 	// LabelEnabled is the label that enables secret copying
 	LabelEnabled = "secret-copy.in-cloud.io"
+	// LabelCopy marks copied secrets in destination clusters (used to filter drift watches)
+	LabelCopy = "secret-copy.in-cloud.io/copy"
 )
 
 // Annotation keys for secret copy configuration
 const (
-	// AnnotationDstKubeconfig specifies the kubeconfig secret reference (namespace/secret-name)
+	// AnnotationDstKubeconfig specifies kubeconfig secret references (namespace/secret-name),
+	// multiple destination clusters are separated by commas
 	AnnotationDstKubeconfig = "secret-copy.in-cloud.io/dstClusterKubeconfig"
-	// AnnotationDstNamespace specifies the target namespace (defaults to source namespace)
+	// AnnotationDstClusterSelector specifies a label selector for kubeconfig secrets of destination clusters
+	AnnotationDstClusterSelector = "secret-copy.in-cloud.io/dstClusterSelector"
+	// AnnotationDstCluster specifies Cluster API Cluster references (namespace/name),
+	// resolved to their <name>-kubeconfig secrets once the control plane is ready
+	AnnotationDstCluster = "secret-copy.in-cloud.io/dstCluster"
+	// AnnotationDstClusterAPISelector specifies a label selector for Cluster API Clusters of destination clusters
+	AnnotationDstClusterAPISelector = "secret-copy.in-cloud.io/dstClusterAPISelector"
+	// AnnotationDstNamespace specifies target namespaces, comma-separated (defaults to source namespace)
 	AnnotationDstNamespace = "secret-copy.in-cloud.io/dstNamespace"
+	// AnnotationDstNamespaceSelector specifies a label selector for target namespaces in destination clusters
+	AnnotationDstNamespaceSelector = "secret-copy.in-cloud.io/dstNamespaceSelector"
+	// AnnotationCreateNamespace set to "true" creates missing listed destination namespaces
+	AnnotationCreateNamespace = "secret-copy.in-cloud.io/createNamespace"
+	// AnnotationNamespaceLabels specifies labels of created namespaces as comma-separated key=value pairs
+	AnnotationNamespaceLabels = "secret-copy.in-cloud.io/namespaceLabels"
+	// AnnotationNamespaceAnnotations specifies annotations of created namespaces as comma-separated key=value pairs
+	AnnotationNamespaceAnnotations = "secret-copy.in-cloud.io/namespaceAnnotations"
+	// AnnotationDstName specifies the name of copies, a Go template over dstNameData (defaults to source name)
+	AnnotationDstName = "secret-copy.in-cloud.io/dstName"
 	// AnnotationDstType specifies the target secret type (defaults to source type)
 	AnnotationDstType = "secret-copy.in-cloud.io/dstType"
-	// AnnotationStrategyIfExist specifies behavior when secret exists: "overwrite" or "ignore"
+	// AnnotationDstKind specifies the kind of copies: "Secret" or "ConfigMap" (defaults to source kind)
+	AnnotationDstKind = "secret-copy.in-cloud.io/dstKind"
+	// AnnotationTransform specifies a built-in data transformation: "dockerconfigjson" or "registry-credentials"
+	AnnotationTransform = "secret-copy.in-cloud.io/transform"
+	// AnnotationAllowSensitiveKeys set to "true" allows copying private keys and credentials of a Secret into a ConfigMap
+	AnnotationAllowSensitiveKeys = "secret-copy.in-cloud.io/allowSensitiveKeys"
+	// AnnotationStrategyIfExist specifies behavior when secret exists: "overwrite", "ignore" or "merge"
 	AnnotationStrategyIfExist = "strategy.secret-copy.in-cloud.io/ifExist"
 	// AnnotationFieldsPrefix is the prefix for field mapping annotations
 	AnnotationFieldsPrefix = "fields.secret-copy.in-cloud.io/"
+	// AnnotationIncludeKeys specifies glob or /regex/ patterns of data keys to copy
+	AnnotationIncludeKeys = "secret-copy.in-cloud.io/includeKeys"
+	// AnnotationExcludeKeys specifies glob or /regex/ patterns of data keys not to copy
+	AnnotationExcludeKeys = "secret-copy.in-cloud.io/excludeKeys"
+	// AnnotationRenameKeys specifies "regex -> replacement" rules renaming copied data keys
+	AnnotationRenameKeys = "secret-copy.in-cloud.io/renameKeys"
+	// AnnotationTemplatePrefix is the prefix for data template annotations, the suffix is the destination key
+	AnnotationTemplatePrefix = "template.secret-copy.in-cloud.io/"
+	// AnnotationResyncPeriod overrides the periodic resync interval (Go duration, "0" disables)
+	AnnotationResyncPeriod = "secret-copy.in-cloud.io/resyncPeriod"
+	// AnnotationDeletionPolicy specifies what happens to copies on source deletion: "Orphan" or "Delete"
+	AnnotationDeletionPolicy = "secret-copy.in-cloud.io/deletionPolicy"
 )
 
+// InClusterDestination in dstClusterKubeconfig targets the cluster the operator runs in,
+// copies are written with the manager's own client without a kubeconfig secret
+const InClusterDestination = "in-cluster"
+
+// AnnotationKubeconfigKey on a kubeconfig secret selects the data key holding kubeconfig,
+// overriding the --kubeconfig-key flag and auto-detection
+const AnnotationKubeconfigKey = "secret-copy.in-cloud.io/kubeconfigKey"
+
+// Annotation keys set on copied secrets
+const (
+	// AnnotationSourceCluster stores the name of the source cluster
+	AnnotationSourceCluster = "secret-copy.in-cloud.io/sourceCluster"
+	// AnnotationSourceSecret stores the source secret reference (namespace/name)
+	AnnotationSourceSecret = "secret-copy.in-cloud.io/sourceSecret"
+	// AnnotationSourceKind stores the kind of the source (Secret or ConfigMap), missing means Secret
+	AnnotationSourceKind = "secret-copy.in-cloud.io/sourceKind"
+	// AnnotationCopiedAt stores the copy timestamp in RFC3339 format
+	AnnotationCopiedAt = "secret-copy.in-cloud.io/copiedAt"
+	// AnnotationSecretCopy stores the SecretCopy resource (namespace/name) that manages the copy
+	AnnotationSecretCopy = "secret-copy.in-cloud.io/secretCopy"
+	// AnnotationClusterSecretCopy stores the ClusterSecretCopy resource name that manages the copy
+	AnnotationClusterSecretCopy = "secret-copy.in-cloud.io/clusterSecretCopy"
+	// AnnotationManagedKeys stores the comma-separated data keys written by the operator with strategy=merge
+	AnnotationManagedKeys = "secret-copy.in-cloud.io/managedKeys"
+)
+
+// FinalizerCleanup is added to source secrets with deletionPolicy=Delete
+// so that copies are removed before the source is released
+const FinalizerCleanup = "secret-copy.in-cloud.io/cleanup"
+
 // AnnotationStatusPrefix is the prefix for all status annotations (used for filtering updates)
 const AnnotationStatusPrefix = "status.secret-copy.in-cloud.io/"
 
@@ -46,6 +114,8 @@ var (
 	AnnotationLastSyncStatus = AnnotationStatusPrefix + "lastSyncStatus"
 	// AnnotationRetryCount stores the current retry count for exponential backoff
 	AnnotationRetryCount = AnnotationStatusPrefix + "retryCount"
+	// AnnotationSyncedTargets stores copies written to destination clusters as a JSON list
+	AnnotationSyncedTargets = AnnotationStatusPrefix + "syncedTargets"
 )
 
 // Status values for AnnotationLastSyncStatus
@@ -56,12 +126,42 @@ const (
 	StatusErrorPrefix = "Error: "
 )
 
+// Event reasons recorded on source secrets
+const (
+	// EventReasonSynced is recorded when a copy is created or updated in a destination cluster
+	EventReasonSynced = "Synced"
+	// EventReasonSyncFailed is recorded when a sync attempt fails
+	EventReasonSyncFailed = "SyncFailed"
+	// EventReasonKubeconfigNotFound is recorded when a referenced kubeconfig secret does not exist
+	EventReasonKubeconfigNotFound = "KubeconfigNotFound"
+	// EventReasonTargetNamespaceMissing is recorded when the destination namespace does not exist
+	EventReasonTargetNamespaceMissing = "TargetNamespaceMissing"
+	// EventReasonNamespaceCreated is recorded when a missing destination namespace is created (createNamespace)
+	EventReasonNamespaceCreated = "NamespaceCreated"
+	// EventReasonSkippedExisting is recorded when an existing destination secret is kept (strategy=ignore)
+	EventReasonSkippedExisting = "SkippedExisting"
+)
+
 // AnnotationPrefixesToFilter contains annotation prefixes that should not be copied to the target secret.
 var AnnotationPrefixesToFilter = []string{
 	"secret-copy.in-cloud.io/",
 	"status.secret-copy.in-cloud.io/",
 	"strategy.secret-copy.in-cloud.io/",
 	"fields.secret-copy.in-cloud.io/",
+	"template.secret-copy.in-cloud.io/",
 	"argocd.argoproj.io/tracking-id",
 	"kubectl.kubernetes.io/last-applied-configuration",
 }
+
+// sourceDestinationsIndex is the field index of labeled sources by the destinations they reference or select
+const sourceDestinationsIndex = "secret-copy.in-cloud.io/destinations"
+
+// Values of sourceDestinationsIndex for sources selecting destinations by labels
+const (
+	// destinationKubeconfigSelector marks sources with dstClusterSelector
+	destinationKubeconfigSelector = "kubeconfigSelector"
+	// destinationClusterAPISelector marks sources with dstClusterAPISelector
+	destinationClusterAPISelector = "clusterAPISelector"
+	// destinationNamespaceSelector marks in-cluster sources with dstNamespaceSelector
+	destinationNamespaceSelector = "namespaceSelector"
+)
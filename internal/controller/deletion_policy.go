@@ -0,0 +1,42 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import "fmt"
+
+// DeletionPolicy defines what happens to copies when the source secret is deleted
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyOrphan leaves copies in destination clusters (default)
+	DeletionPolicyOrphan DeletionPolicy = "Orphan"
+	// DeletionPolicyDelete removes copies from destination clusters
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+)
+
+// ParseDeletionPolicy parses and validates deletion policy from annotation value.
+// Returns DeletionPolicyOrphan if value is empty.
+func ParseDeletionPolicy(value string) (DeletionPolicy, error) {
+	if value == "" {
+		return DeletionPolicyOrphan, nil
+	}
+	p := DeletionPolicy(value)
+	if p != DeletionPolicyOrphan && p != DeletionPolicyDelete {
+		return "", fmt.Errorf("invalid deletion policy %q, expected %q or %q", value, DeletionPolicyOrphan, DeletionPolicyDelete)
+	}
+	return p, nil
+}
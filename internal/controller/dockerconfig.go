@@ -0,0 +1,181 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Transform is a built-in transformation of the copied data
+type Transform string
+
+const (
+	// TransformDockerConfigJSON builds a .dockerconfigjson pull secret from registry credentials
+	TransformDockerConfigJSON Transform = "dockerconfigjson"
+	// TransformRegistryCredentials extracts registry credentials from a .dockerconfigjson or .dockercfg pull secret
+	TransformRegistryCredentials Transform = "registry-credentials"
+)
+
+// Keys of registry credentials consumed and produced by transforms
+const (
+	RegistryServerKey   = "server"
+	RegistryUsernameKey = "username"
+	RegistryPasswordKey = "password"
+	RegistryEmailKey    = "email"
+)
+
+// ParseTransform parses and validates transform from annotation value.
+// Returns an empty transform if value is empty.
+func ParseTransform(value string) (Transform, error) {
+	t := Transform(value)
+	if t != "" && t != TransformDockerConfigJSON && t != TransformRegistryCredentials {
+		return "", fmt.Errorf("invalid transform %q, expected %q or %q",
+			value, TransformDockerConfigJSON, TransformRegistryCredentials)
+	}
+	return t, nil
+}
+
+// secretTypes returns the copy types the transform produces data for, the first one is the default
+func (t Transform) secretTypes() []corev1.SecretType {
+	switch t {
+	case TransformDockerConfigJSON:
+		return []corev1.SecretType{corev1.SecretTypeDockerConfigJson}
+	case TransformRegistryCredentials:
+		return []corev1.SecretType{corev1.SecretTypeOpaque, corev1.SecretTypeBasicAuth}
+	}
+	return nil
+}
+
+// dockerAuth is a registry entry of a docker config
+type dockerAuth struct {
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	Email    string `json:"email,omitempty"`
+	Auth     string `json:"auth,omitempty"`
+}
+
+// dockerConfigJSON is the content of a .dockerconfigjson key
+type dockerConfigJSON struct {
+	Auths map[string]dockerAuth `json:"auths"`
+}
+
+// apply returns the data transformed, keys not used by the transform are kept
+func (t Transform) apply(data map[string][]byte) (map[string][]byte, error) {
+	switch t {
+	case TransformDockerConfigJSON:
+		return buildDockerConfigJSON(data)
+	case TransformRegistryCredentials:
+		return extractRegistryCredentials(data)
+	}
+	return data, nil
+}
+
+// buildDockerConfigJSON replaces server, username, password and optional email with a .dockerconfigjson key
+func buildDockerConfigJSON(data map[string][]byte) (map[string][]byte, error) {
+	var missing []string
+	for _, key := range []string{RegistryServerKey, RegistryUsernameKey, RegistryPasswordKey} {
+		if len(data[key]) == 0 {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("transform %s requires keys %s", TransformDockerConfigJSON, strings.Join(missing, ", "))
+	}
+
+	username, password := string(data[RegistryUsernameKey]), string(data[RegistryPasswordKey])
+	payload, err := json.Marshal(dockerConfigJSON{Auths: map[string]dockerAuth{
+		string(data[RegistryServerKey]): {
+			Username: username,
+			Password: password,
+			Email:    string(data[RegistryEmailKey]),
+			Auth:     base64.StdEncoding.EncodeToString([]byte(username + ":" + password)),
+		},
+	}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to build %s: %w", corev1.DockerConfigJsonKey, err)
+	}
+
+	result := make(map[string][]byte, len(data))
+	for k, v := range data {
+		switch k {
+		case RegistryServerKey, RegistryUsernameKey, RegistryPasswordKey, RegistryEmailKey:
+		default:
+			result[k] = v
+		}
+	}
+	result[corev1.DockerConfigJsonKey] = payload
+	return result, nil
+}
+
+// extractRegistryCredentials replaces .dockerconfigjson or .dockercfg with server, username, password
+// and email of its only registry. Credentials given only as auth are decoded.
+func extractRegistryCredentials(data map[string][]byte) (map[string][]byte, error) {
+	var auths map[string]dockerAuth
+	key := corev1.DockerConfigJsonKey
+	switch {
+	case len(data[corev1.DockerConfigJsonKey]) > 0:
+		var config dockerConfigJSON
+		if err := json.Unmarshal(data[corev1.DockerConfigJsonKey], &config); err != nil {
+			return nil, fmt.Errorf("transform %s: invalid %s: %w", TransformRegistryCredentials, key, err)
+		}
+		auths = config.Auths
+	case len(data[corev1.DockerConfigKey]) > 0:
+		key = corev1.DockerConfigKey
+		if err := json.Unmarshal(data[corev1.DockerConfigKey], &auths); err != nil {
+			return nil, fmt.Errorf("transform %s: invalid %s: %w", TransformRegistryCredentials, key, err)
+		}
+	default:
+		return nil, fmt.Errorf("transform %s requires key %s or %s",
+			TransformRegistryCredentials, corev1.DockerConfigJsonKey, corev1.DockerConfigKey)
+	}
+	if len(auths) != 1 {
+		return nil, fmt.Errorf("transform %s: %s has %d registries, expected exactly one",
+			TransformRegistryCredentials, key, len(auths))
+	}
+
+	var server string
+	var auth dockerAuth
+	for s, a := range auths {
+		server, auth = s, a
+	}
+	if auth.Username == "" && auth.Password == "" && auth.Auth != "" {
+		decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+		if err != nil {
+			return nil, fmt.Errorf("transform %s: invalid auth of registry %s: %w", TransformRegistryCredentials, server, err)
+		}
+		auth.Username, auth.Password, _ = strings.Cut(string(decoded), ":")
+	}
+
+	result := make(map[string][]byte, len(data)+2)
+	for k, v := range data {
+		if k != corev1.DockerConfigJsonKey && k != corev1.DockerConfigKey {
+			result[k] = v
+		}
+	}
+	result[RegistryServerKey] = []byte(server)
+	result[RegistryUsernameKey] = []byte(auth.Username)
+	result[RegistryPasswordKey] = []byte(auth.Password)
+	if auth.Email != "" {
+		result[RegistryEmailKey] = []byte(auth.Email)
+	}
+	return result, nil
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// keyPattern matches data keys by a glob or, if written as /regex/, by a regular expression
+type keyPattern struct {
+	glob  string
+	regex *regexp.Regexp
+}
+
+// matches returns true if the key matches the pattern
+func (p keyPattern) matches(key string) bool {
+	if p.regex != nil {
+		return p.regex.MatchString(key)
+	}
+	matched, _ := path.Match(p.glob, key)
+	return matched
+}
+
+// renameRule renames data keys matching regex to replacement, which may reference capture groups
+type renameRule struct {
+	regex       *regexp.Regexp
+	replacement string
+}
+
+// splitPatterns splits a list of patterns. Multi-line values are split by lines only,
+// so that regular expressions may contain commas, single-line values are split by commas.
+func splitPatterns(value string) []string {
+	separator := ","
+	if strings.Contains(value, "\n") {
+		separator = "\n"
+	}
+
+	var items []string
+	for _, item := range strings.Split(value, separator) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// parseKeyPatterns parses glob and /regex/ patterns from the annotation, empty value means nil
+func parseKeyPatterns(annotation, value string) ([]keyPattern, error) {
+	var patterns []keyPattern
+	for _, item := range splitPatterns(value) {
+		if len(item) > 2 && strings.HasPrefix(item, "/") && strings.HasSuffix(item, "/") {
+			regex, err := regexp.Compile(item[1 : len(item)-1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s pattern %q: %w", annotation, item, err)
+			}
+			patterns = append(patterns, keyPattern{regex: regex})
+			continue
+		}
+		if _, err := path.Match(item, ""); err != nil {
+			return nil, fmt.Errorf("invalid %s pattern %q: %w", annotation, item, err)
+		}
+		patterns = append(patterns, keyPattern{glob: item})
+	}
+	return patterns, nil
+}
+
+// parseRenameRules parses "regex -> replacement" rules from the annotation, empty value means nil
+func parseRenameRules(annotation, value string) ([]renameRule, error) {
+	var rules []renameRule
+	for _, item := range splitPatterns(value) {
+		expr, replacement, ok := strings.Cut(item, "->")
+		expr, replacement = strings.TrimSpace(expr), strings.TrimSpace(replacement)
+		if !ok || expr == "" || replacement == "" {
+			return nil, fmt.Errorf("invalid %s rule %q, expected 'regex -> replacement'", annotation, item)
+		}
+		regex, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s rule %q: %w", annotation, item, err)
+		}
+		rules = append(rules, renameRule{regex: regex, replacement: replacement})
+	}
+	return rules, nil
+}
+
+// matchesAny returns true if the key matches one of the patterns
+func matchesAny(patterns []keyPattern, key string) bool {
+	for _, pattern := range patterns {
+		if pattern.matches(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// copiedKey returns the destination key of a source key and whether the key is copied.
+// Precedence: an explicit field mapping always copies the key under the mapped name; otherwise
+// the key must match includeKeys if set (or be mapped if only field mappings are set), must not
+// match excludeKeys, and is renamed by the first matching renameKeys rule.
+func (c *CopyConfig) copiedKey(srcKey string) (string, bool) {
+	if dstKey, ok := c.FieldsMapping[srcKey]; ok {
+		return dstKey, true
+	}
+	if len(c.IncludeKeys) > 0 {
+		if !matchesAny(c.IncludeKeys, srcKey) {
+			return "", false
+		}
+	} else if len(c.FieldsMapping) > 0 {
+		return "", false
+	}
+	if matchesAny(c.ExcludeKeys, srcKey) {
+		return "", false
+	}
+
+	for _, rule := range c.RenameKeys {
+		if rule.regex.MatchString(srcKey) {
+			dstKey := rule.regex.ReplaceAllString(srcKey, rule.replacement)
+			return dstKey, dstKey != ""
+		}
+	}
+	return srcKey, true
+}
+
+// applyKeyRules returns the data with keys selected and renamed by config.
+// If several source keys end up with the same destination key, an explicitly mapped key wins,
+// then the lexicographically smallest source key.
+func applyKeyRules[V any](sourceData map[string]V, config *CopyConfig) map[string]V {
+	srcKeys := make([]string, 0, len(sourceData))
+	for k := range sourceData {
+		srcKeys = append(srcKeys, k)
+	}
+	sort.Strings(srcKeys)
+
+	result := make(map[string]V, len(sourceData))
+	for _, explicit := range []bool{true, false} {
+		for _, srcKey := range srcKeys {
+			if _, mapped := config.FieldsMapping[srcKey]; mapped != explicit {
+				continue
+			}
+			dstKey, copied := config.copiedKey(srcKey)
+			if !copied {
+				continue
+			}
+			if _, taken := result[dstKey]; !taken {
+				result[dstKey] = sourceData[srcKey]
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Error classes for the syncFailures metric
+const (
+	errorClassKubeconfigNotFound = "kubeconfig_not_found"
+	errorClassKubeconfigInvalid  = "kubeconfig_invalid"
+	errorClassNamespaceMissing   = "namespace_missing"
+	errorClassAPI                = "api_error"
+)
+
+var (
+	syncAttempts = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "secret_copy_sync_attempts_total",
+			Help: "Number of attempts to copy a secret to a destination cluster",
+		},
+		[]string{"cluster"},
+	)
+
+	syncSuccesses = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "secret_copy_sync_success_total",
+			Help: "Number of successful copies of a secret to a destination cluster",
+		},
+		[]string{"cluster"},
+	)
+
+	syncFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "secret_copy_sync_failures_total",
+			Help: "Number of failed copies of a secret to a destination cluster by error class",
+		},
+		[]string{"cluster", "error_class"},
+	)
+
+	syncDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "secret_copy_sync_duration_seconds",
+			Help:    "Time spent writing a copy to a destination cluster",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"cluster"},
+	)
+
+	lastSuccessfulSync = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "secret_copy_last_successful_sync_timestamp_seconds",
+			Help: "Unix time of the last sync of a source Secret or ConfigMap to all of its destinations",
+		},
+		[]string{"kind", "namespace", "name"},
+	)
+
+	retryCount = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "secret_copy_retry_count",
+			Help: "Current number of consecutive failed syncs of a source Secret or ConfigMap",
+		},
+		[]string{"kind", "namespace", "name"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		syncAttempts,
+		syncSuccesses,
+		syncFailures,
+		syncDuration,
+		lastSuccessfulSync,
+		retryCount,
+	)
+}
+
+// recordSourceSynced marks a source as successfully synced to all destinations
+func recordSourceSynced(kind string, source types.NamespacedName) {
+	lastSuccessfulSync.WithLabelValues(kind, source.Namespace, source.Name).Set(float64(time.Now().Unix()))
+}
+
+// recordSourceRetry records the current retry count of a source
+func recordSourceRetry(kind string, source types.NamespacedName, count int) {
+	retryCount.WithLabelValues(kind, source.Namespace, source.Name).Set(float64(count))
+}
+
+// forgetSource removes per-source series of a source that is no longer copied
+func forgetSource(kind string, source types.NamespacedName) {
+	lastSuccessfulSync.DeleteLabelValues(kind, source.Namespace, source.Name)
+	retryCount.DeleteLabelValues(kind, source.Namespace, source.Name)
+}
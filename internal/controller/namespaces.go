@@ -0,0 +1,141 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// namespaceRescanPeriod is how often sources selecting destination namespaces look for new
+// namespaces in remote clusters when resync is disabled
+const namespaceRescanPeriod = 5 * time.Minute
+
+// namespaceResult is the outcome of copying the source into one destination namespace
+type namespaceResult struct {
+	target syncTarget
+	err    error
+}
+
+// syncToNamespaces copies the source to every destination namespace of the cluster referenced
+// by kubeconfigRef. An error is returned if the cluster or its namespaces cannot be read,
+// failures of single namespaces are reported in the results.
+func (r *SecretCopyReconciler) syncToNamespaces(
+	ctx context.Context,
+	source client.Object,
+	kubeconfigRef types.NamespacedName,
+	config *CopyConfig,
+) ([]namespaceResult, error) {
+	targetClient, err := r.targetClientFor(ctx, source, kubeconfigRef)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces, err := r.destinationNamespaces(ctx, source, kubeconfigRef, targetClient, config)
+	if err != nil {
+		syncAttempts.WithLabelValues(clusterName(kubeconfigRef)).Inc()
+		syncFailures.WithLabelValues(clusterName(kubeconfigRef), errorClassAPI).Inc()
+		return nil, err
+	}
+
+	results := make([]namespaceResult, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		nsConfig := config.forNamespace(namespace)
+		results = append(results, namespaceResult{
+			target: newSyncTarget(kubeconfigRef, nsConfig),
+			err:    r.syncToNamespace(ctx, source, kubeconfigRef, targetClient, nsConfig),
+		})
+	}
+	return results, nil
+}
+
+// destinationNamespaces returns the listed namespaces followed by the namespaces of the destination
+// cluster selected by dstNamespaceSelector. Terminating namespaces are not selected, neither is
+// the source namespace if the copy would overwrite the source.
+func (r *SecretCopyReconciler) destinationNamespaces(
+	ctx context.Context,
+	source client.Object,
+	kubeconfigRef types.NamespacedName,
+	targetClient client.Client,
+	config *CopyConfig,
+) ([]string, error) {
+	namespaces := slices.Clone(config.listedNamespaces())
+	if config.DstNamespaceSelector == nil {
+		return namespaces, nil
+	}
+
+	list := &corev1.NamespaceList{}
+	if err := targetClient.List(ctx, list, client.MatchingLabelsSelector{Selector: config.DstNamespaceSelector}); err != nil {
+		return nil, fmt.Errorf("failed to list namespaces: %w", err)
+	}
+
+	selfCopy := isInCluster(kubeconfigRef) && destinationKind(source, config) == sourceKind(source) &&
+		config.DstSecretName == source.GetName()
+
+	var selected []string
+	for i := range list.Items {
+		ns := &list.Items[i]
+		if !ns.DeletionTimestamp.IsZero() || slices.Contains(namespaces, ns.Name) {
+			continue
+		}
+		if selfCopy && ns.Name == source.GetNamespace() {
+			log.FromContext(ctx).V(1).Info("Skipping source namespace selected for in-cluster copy", "namespace", ns.Name)
+			continue
+		}
+		selected = append(selected, ns.Name)
+	}
+	// List order is not guaranteed, keep status messages and logs stable
+	sort.Strings(selected)
+
+	return append(namespaces, selected...), nil
+}
+
+// findSourcesOfKindForNamespace maps a namespace of the operator's cluster to the sources of the list kind
+// that copy in-cluster into namespaces selected by labels, or hold a copy in the namespace
+func (r *SecretCopyReconciler) findSourcesOfKindForNamespace(
+	ctx context.Context,
+	sources client.ObjectList,
+	obj client.Object,
+) []reconcile.Request {
+	namespaceLabels := labels.Set(obj.GetLabels())
+
+	return r.findSources(ctx, sources, []string{destinationNamespaceSelector}, func(source client.Object, config *CopyConfig) bool {
+		if config.DstNamespaceSelector == nil || !slices.Contains(config.DstKubeconfigRefs, inClusterRef) {
+			return false
+		}
+		if config.DstNamespaceSelector.Matches(namespaceLabels) {
+			return true
+		}
+		// A namespace that no longer matches leaves a stale copy behind
+		for _, target := range getSyncedTargets(source) {
+			if target.Cluster == InClusterDestination && target.Namespace == obj.GetName() {
+				return true
+			}
+		}
+		return false
+	})
+}
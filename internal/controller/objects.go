@@ -0,0 +1,388 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Kinds of source objects, used in metrics, events and status messages
+const (
+	KindSecret    = "Secret"
+	KindConfigMap = "ConfigMap"
+)
+
+// sensitiveKeys are data keys of well-known secret types holding private keys or credentials
+var sensitiveKeys = []string{
+	corev1.TLSPrivateKeyKey,
+	corev1.SSHAuthPrivateKey,
+	corev1.BasicAuthPasswordKey,
+	corev1.ServiceAccountTokenKey,
+	corev1.DockerConfigJsonKey,
+	corev1.DockerConfigKey,
+}
+
+// copyContent is the payload written to a copy
+type copyContent struct {
+	data       map[string][]byte // Secret data or ConfigMap binaryData
+	stringData map[string]string // ConfigMap data
+	secretType corev1.SecretType // Secret copies only
+}
+
+// sourceKind returns the kind of a source or copied object
+func sourceKind(obj client.Object) string {
+	if _, ok := obj.(*corev1.ConfigMap); ok {
+		return KindConfigMap
+	}
+	return KindSecret
+}
+
+// copySourceKind returns the kind of the source of a copy, copies made before the kind was
+// recorded come from Secrets
+func copySourceKind(obj client.Object) string {
+	if kind := obj.GetAnnotations()[AnnotationSourceKind]; kind != "" {
+		return kind
+	}
+	return KindSecret
+}
+
+// newObject returns an empty Secret or ConfigMap of the kind
+func newObject(kind string) client.Object {
+	if kind == KindConfigMap {
+		return &corev1.ConfigMap{}
+	}
+	return &corev1.Secret{}
+}
+
+// ParseKind parses and validates the kind of copies from annotation value.
+// Returns empty string if value is empty.
+func ParseKind(value string) (string, error) {
+	if value == "" || value == KindSecret || value == KindConfigMap {
+		return value, nil
+	}
+	return "", fmt.Errorf("invalid %s %q, expected %q or %q", AnnotationDstKind, value, KindSecret, KindConfigMap)
+}
+
+// destinationKind returns the kind of copies of source
+func destinationKind(source client.Object, config *CopyConfig) string {
+	if config.DstKind != "" {
+		return config.DstKind
+	}
+	return sourceKind(source)
+}
+
+// prepareContent returns the content of the copy considering key selection, data templates, transform,
+// dstType and dstKind. The transform is applied last, to the data of Secret copies.
+// Secret data converted to a ConfigMap goes to data if it is valid UTF-8 and to binaryData otherwise,
+// ConfigMap data and binaryData converted to a Secret are merged into data of an Opaque secret.
+func (r *SecretCopyReconciler) prepareContent(source client.Object, config *CopyConfig) (copyContent, error) {
+	rendered, err := renderDataTemplates(source, config)
+	if err != nil {
+		return copyContent{}, err
+	}
+
+	var content copyContent
+	switch src := source.(type) {
+	case *corev1.ConfigMap:
+		content.data = applyKeyRules(src.BinaryData, config)
+		content.stringData = applyKeyRules(src.Data, config)
+		content.secretType = corev1.SecretTypeOpaque
+		for k, v := range rendered {
+			if content.stringData == nil {
+				content.stringData = make(map[string]string, len(rendered))
+			}
+			content.stringData[k] = string(v)
+			delete(content.data, k)
+		}
+	case *corev1.Secret:
+		content.data = r.prepareData(src.Data, config)
+		content.secretType = src.Type
+		for k, v := range rendered {
+			if content.data == nil {
+				content.data = make(map[string][]byte, len(rendered))
+			}
+			content.data[k] = v
+		}
+	}
+
+	if destinationKind(source, config) == KindConfigMap {
+		if _, ok := source.(*corev1.Secret); ok {
+			content.stringData = make(map[string]string, len(content.data))
+			binaryData := make(map[string][]byte)
+			for k, v := range content.data {
+				if utf8.Valid(v) {
+					content.stringData[k] = string(v)
+				} else {
+					binaryData[k] = v
+				}
+			}
+			content.data = nil
+			if len(binaryData) > 0 {
+				content.data = binaryData
+			}
+		}
+		content.secretType = ""
+		return content, nil
+	}
+
+	for k, v := range content.stringData {
+		if content.data == nil {
+			content.data = make(map[string][]byte, len(content.stringData))
+		}
+		content.data[k] = []byte(v)
+	}
+	content.stringData = nil
+	content.secretType = r.resolveSecretType(content.secretType, config.DstType)
+	if config.Transform != "" {
+		if content.data, err = config.Transform.apply(content.data); err != nil {
+			return copyContent{}, err
+		}
+		content.secretType = r.resolveSecretType(config.Transform.secretTypes()[0], config.DstType)
+	}
+	if err := checkSecretTypeKeys(content.secretType, content.data); err != nil {
+		return copyContent{}, err
+	}
+	return content, nil
+}
+
+// requiredTypeKeys are data keys the API server requires for built-in secret types,
+// a secret of the type must have all of them
+var requiredTypeKeys = map[corev1.SecretType][]string{
+	corev1.SecretTypeTLS:              {corev1.TLSCertKey, corev1.TLSPrivateKeyKey},
+	corev1.SecretTypeSSHAuth:          {corev1.SSHAuthPrivateKey},
+	corev1.SecretTypeDockerConfigJson: {corev1.DockerConfigJsonKey},
+	corev1.SecretTypeDockercfg:        {corev1.DockerConfigKey},
+}
+
+// checkSecretTypeKeys returns an error if the copy data does not satisfy the requirements of a built-in
+// secret type, which the API server would reject on every sync. Service account tokens are refused,
+// their copies would be bound to a service account of the destination cluster.
+func checkSecretTypeKeys(secretType corev1.SecretType, data map[string][]byte) error {
+	switch secretType {
+	case corev1.SecretTypeServiceAccountToken:
+		return fmt.Errorf("refusing to copy a secret of type %s, set the destination type to %s to copy the token data",
+			secretType, corev1.SecretTypeOpaque)
+	case corev1.SecretTypeBasicAuth:
+		if _, ok := data[corev1.BasicAuthUsernameKey]; ok {
+			return nil
+		}
+		if _, ok := data[corev1.BasicAuthPasswordKey]; ok {
+			return nil
+		}
+		return fmt.Errorf("secret type %s requires key %s or %s, the copy has neither; "+
+			"keep one of them in the field mapping or change the destination type",
+			secretType, corev1.BasicAuthUsernameKey, corev1.BasicAuthPasswordKey)
+	}
+
+	var missing []string
+	for _, key := range requiredTypeKeys[secretType] {
+		if _, ok := data[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("secret type %s requires keys %s, the copy is missing %s; "+
+			"keep them in the field mapping or change the destination type",
+			secretType, strings.Join(requiredTypeKeys[secretType], ", "), strings.Join(missing, ", "))
+	}
+
+	// Docker configs are also parsed by the API server
+	if secretType == corev1.SecretTypeDockerConfigJson || secretType == corev1.SecretTypeDockercfg {
+		key := requiredTypeKeys[secretType][0]
+		if !json.Valid(data[key]) {
+			return fmt.Errorf("secret type %s requires valid JSON in key %s", secretType, key)
+		}
+	}
+	return nil
+}
+
+// checkSensitiveKeys returns an error if a Secret is copied into a ConfigMap with keys of
+// private keys or credentials, or with data templates that could read them, unless allowSensitiveKeys is set
+func checkSensitiveKeys(source client.Object, config *CopyConfig) error {
+	secret, ok := source.(*corev1.Secret)
+	if !ok || destinationKind(source, config) != KindConfigMap || config.AllowSensitiveKeys {
+		return nil
+	}
+
+	// Data templates can read every source key, whatever keys are selected for copying
+	if len(config.DataTemplates) > 0 {
+		var readable []string
+		for srcKey := range secret.Data {
+			if slices.Contains(sensitiveKeys, srcKey) {
+				readable = append(readable, srcKey)
+			}
+		}
+		if len(readable) > 0 {
+			sort.Strings(readable)
+			return fmt.Errorf("refusing to render data templates into a ConfigMap from a Secret with sensitive keys %s, "+
+				"set %s to \"true\" to allow", strings.Join(readable, ", "), AnnotationAllowSensitiveKeys)
+		}
+	}
+
+	var found []string
+	for srcKey := range secret.Data {
+		dstKey, copied := config.copiedKey(srcKey)
+		if !copied {
+			continue
+		}
+		if slices.Contains(sensitiveKeys, srcKey) || slices.Contains(sensitiveKeys, dstKey) {
+			found = append(found, srcKey)
+		}
+	}
+	for dstKey := range config.DataTemplates {
+		if slices.Contains(sensitiveKeys, dstKey) && !slices.Contains(found, dstKey) {
+			found = append(found, dstKey)
+		}
+	}
+	if len(found) == 0 {
+		return nil
+	}
+
+	sort.Strings(found)
+	return fmt.Errorf("refusing to copy sensitive keys %s into a ConfigMap, set %s to \"true\" to allow",
+		strings.Join(found, ", "), AnnotationAllowSensitiveKeys)
+}
+
+// applyTo writes the content into a Secret or ConfigMap
+func (c copyContent) applyTo(obj client.Object) {
+	switch dst := obj.(type) {
+	case *corev1.ConfigMap:
+		dst.Data = c.stringData
+		dst.BinaryData = c.data
+	case *corev1.Secret:
+		dst.Data = c.data
+		dst.Type = c.secretType
+	}
+}
+
+// matches returns true if the Secret or ConfigMap already holds the content
+func (c copyContent) matches(obj client.Object) bool {
+	switch dst := obj.(type) {
+	case *corev1.ConfigMap:
+		return dataEqual(dst.Data, c.stringData) && dataEqual(dst.BinaryData, c.data)
+	case *corev1.Secret:
+		return dst.Type == c.secretType && dataEqual(dst.Data, c.data)
+	}
+	return false
+}
+
+// keys returns the sorted data keys of the content
+func (c copyContent) keys() []string {
+	keys := make([]string, 0, len(c.data)+len(c.stringData))
+	for k := range c.data {
+		keys = append(keys, k)
+	}
+	for k := range c.stringData {
+		if _, ok := c.data[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// managedKeys returns the data keys the operator wrote into the copy with strategy=merge
+func managedKeys(obj client.Object) []string {
+	var keys []string
+	for _, key := range strings.Split(obj.GetAnnotations()[AnnotationManagedKeys], ",") {
+		if key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// mergedWith returns the content merged into the data of the existing Secret or ConfigMap:
+// keys of the content are written, managed keys missing from the content are removed and
+// other keys of the existing object are kept
+func (c copyContent) mergedWith(existing client.Object, managed []string) copyContent {
+	var data map[string][]byte
+	var stringData map[string]string
+	switch dst := existing.(type) {
+	case *corev1.ConfigMap:
+		data, stringData = maps.Clone(dst.BinaryData), maps.Clone(dst.Data)
+	case *corev1.Secret:
+		data = maps.Clone(dst.Data)
+	}
+
+	for _, key := range managed {
+		delete(data, key)
+		delete(stringData, key)
+	}
+	// A ConfigMap key moves between data and binaryData when its value stops or starts being UTF-8
+	for k := range c.stringData {
+		delete(data, k)
+	}
+	for k := range c.data {
+		delete(stringData, k)
+	}
+
+	merged := copyContent{secretType: c.secretType}
+	if len(data) > 0 || len(c.data) > 0 {
+		merged.data = data
+		if merged.data == nil {
+			merged.data = make(map[string][]byte, len(c.data))
+		}
+		maps.Copy(merged.data, c.data)
+	}
+	if len(stringData) > 0 || len(c.stringData) > 0 {
+		merged.stringData = stringData
+		if merged.stringData == nil {
+			merged.stringData = make(map[string]string, len(c.stringData))
+		}
+		maps.Copy(merged.stringData, c.stringData)
+	}
+	return merged
+}
+
+// sourceSpecChanged returns true if source data, labels, or config annotations changed
+func sourceSpecChanged(oldObj, newObj client.Object) bool {
+	switch oldSource := oldObj.(type) {
+	case *corev1.Secret:
+		newSource, ok := newObj.(*corev1.Secret)
+		if !ok || !reflect.DeepEqual(oldSource.Data, newSource.Data) {
+			return true
+		}
+	case *corev1.ConfigMap:
+		newSource, ok := newObj.(*corev1.ConfigMap)
+		if !ok || !reflect.DeepEqual(oldSource.Data, newSource.Data) ||
+			!reflect.DeepEqual(oldSource.BinaryData, newSource.BinaryData) {
+			return true
+		}
+	default:
+		return true
+	}
+
+	if !reflect.DeepEqual(oldObj.GetLabels(), newObj.GetLabels()) {
+		return true
+	}
+
+	// Compare annotations (excluding status.*)
+	oldAnnotations := filterStatusAnnotations(oldObj.GetAnnotations())
+	newAnnotations := filterStatusAnnotations(newObj.GetAnnotations())
+	return !reflect.DeepEqual(oldAnnotations, newAnnotations)
+}
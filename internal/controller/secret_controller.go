@@ -19,23 +19,32 @@ package controller
 import (
 	"context"
 	"fmt"
+	"maps"
 	"reflect"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	ctrlsource "sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 // SecretCopyReconciler reconciles a Secret object
@@ -45,147 +54,827 @@ type SecretCopyReconciler struct {
 	ClusterClientGetter     ClusterClientGetter
 	MaxConcurrentReconciles int
 	ClusterName             string
+	// ResyncPeriod is the default interval for re-verifying copies after a successful sync, 0 disables
+	ResyncPeriod time.Duration
+	// DriftEvents delivers copies of sources of the reconciled kind modified or deleted in destination
+	// clusters, nil disables drift detection. Only copies that are Secrets are watched.
+	DriftEvents <-chan event.GenericEvent
+	// Recorder records sync Events on source secrets, nil disables events
+	Recorder record.EventRecorder
+	// WatchClusterAPI enqueues sources when Cluster API Clusters become ready, requires the Cluster CRD
+	WatchClusterAPI bool
 }
 
-// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch
+// copyResult describes what copySecret did with the destination secret
+type copyResult int
+
+const (
+	// copyUnchanged means the destination secret was already up to date or nothing was written
+	copyUnchanged copyResult = iota
+	// copyCreated means the destination secret was created
+	copyCreated
+	// copyUpdated means the existing destination secret was overwritten
+	copyUpdated
+	// copySkipped means the destination secret exists and strategy=ignore
+	copySkipped
+	// copyNamespaceMissing means the destination namespace does not exist
+	copyNamespaceMissing
+)
+
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=create
 
 func (r *SecretCopyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.reconcileSource(ctx, req, &corev1.Secret{})
+}
+
+// reconcileSource copies the source Secret or ConfigMap named by req to its destinations.
+// source is an empty object of the source kind.
+func (r *SecretCopyReconciler) reconcileSource(ctx context.Context, req ctrl.Request, source client.Object) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	config, result, err := r.loadSource(ctx, req, source)
+	if config == nil {
+		return result, err
+	}
+
+	destinations, pending, err := r.resolveDestinations(ctx, config)
+	if err != nil {
+		logger.Error(err, "Failed to resolve destination clusters")
+		r.recordEvent(source, corev1.EventTypeWarning, EventReasonSyncFailed, "%s", err.Error())
+		delay, _ := r.updateStatusWithRetry(ctx, source, StatusErrorPrefix+err.Error(), true)
+		return ctrl.Result{RequeueAfter: delay}, nil
+	}
+
+	logger.Info("Reconciling source",
+		"kind", sourceKind(source),
+		"source", req.NamespacedName,
+		"dstKubeconfigs", destinations,
+		"pendingKubeconfigs", pending,
+		"dstNamespaces", config.listedNamespaces(),
+		"dstNamespaceSelector", config.DstNamespaceSelector,
+	)
+
+	previous := getSyncedTargets(source)
+	desired := make([]syncTarget, 0, len(destinations)+len(pending))
+	synced := make([]syncTarget, 0, len(destinations)+len(pending))
+
+	// Clusters with a control plane that is not ready are synced once it becomes ready,
+	// their existing copies are neither touched nor pruned meanwhile
+	for _, ref := range pending {
+		for _, target := range previous {
+			if target.Cluster == clusterName(ref) {
+				desired = append(desired, target)
+				synced = append(synced, target)
+			}
+		}
+	}
+
+	// Each destination cluster and namespace is synced independently so that one
+	// unreachable cluster or missing namespace does not block the others
+	var syncErrors []string
+	for _, ref := range destinations {
+		results, err := r.syncToNamespaces(ctx, source, ref, config)
+		if err != nil {
+			syncErrors = append(syncErrors, fmt.Sprintf("%s: %s", clusterName(ref), err.Error()))
+			// Destination namespaces are unknown, keep tracking the copies in this cluster
+			for _, target := range previous {
+				if target.Cluster == clusterName(ref) {
+					desired = append(desired, target)
+					synced = append(synced, target)
+				}
+			}
+			continue
+		}
+
+		for _, result := range results {
+			desired = append(desired, result.target)
+			if result.err != nil {
+				syncErrors = append(syncErrors, fmt.Sprintf("%s: %s", clusterName(ref), result.err.Error()))
+				// The copy may still exist from a previous sync, keep tracking it
+				if slices.Contains(previous, result.target) {
+					synced = append(synced, result.target)
+				}
+				continue
+			}
+			synced = append(synced, result.target)
+		}
+	}
+
+	// Copies left behind after the destination changed
+	for _, target := range previous {
+		if slices.Contains(desired, target) {
+			continue
+		}
+		if config.DeletionPolicy != DeletionPolicyDelete {
+			logger.Info("Forgetting stale copy", "target", target.String(), "deletionPolicy", config.DeletionPolicy)
+			continue
+		}
+		if err := r.deleteTarget(ctx, source, target); err != nil {
+			syncErrors = append(syncErrors, fmt.Sprintf("%s: failed to prune stale copy: %s", target, err.Error()))
+			synced = append(synced, target)
+		}
+	}
+
+	if err := r.updateSyncedTargets(ctx, source, synced); err != nil {
+		logger.Error(err, "Failed to record synced targets")
+	}
+
+	if len(syncErrors) > 0 {
+		r.recordEvent(source, corev1.EventTypeWarning, EventReasonSyncFailed, "%s", strings.Join(syncErrors, "; "))
+		delay, _ := r.updateStatusWithRetry(ctx, source, StatusErrorPrefix+strings.Join(syncErrors, "; "), true)
+		logger.Info("Scheduling retry", "delay", delay, "errors", len(syncErrors))
+		return ctrl.Result{RequeueAfter: delay}, nil
+	}
+
+	// Ready clusters are synced, but the source is not synced until every control plane is ready
+	if len(pending) > 0 {
+		message := "waiting for control plane of clusters: " + formatClusterAPIClusters(pending)
+		logger.Info("Destination clusters are not ready", "pending", pending)
+		_, _ = r.updateStatusWithRetry(ctx, source, StatusErrorPrefix+message, false)
+		return ctrl.Result{RequeueAfter: r.pendingResyncPeriod(config)}, nil
+	}
+
+	if len(destinations) == 0 {
+		// Wait for a matching kubeconfig secret to appear, the kubeconfig watch will enqueue us
+		selector := destinationSelectors(config)
+		logger.Info("No destination clusters match selector", "selector", selector)
+		r.recordEvent(source, corev1.EventTypeWarning, EventReasonSyncFailed,
+			"No destination clusters match selector %q", selector)
+		_, _ = r.updateStatusWithRetry(ctx, source, StatusErrorPrefix+"no destination clusters match selector", false)
+		return ctrl.Result{}, nil
+	}
+
+	_, _ = r.updateStatusWithRetry(ctx, source, StatusSynced, false)
+	recordSourceSynced(sourceKind(source), req.NamespacedName)
+	return ctrl.Result{RequeueAfter: r.resyncPeriod(config)}, nil
+}
+
+// loadSource fetches the source and parses and validates its configuration.
+// A nil config means the source must not be copied, result and error are then returned as is.
+func (r *SecretCopyReconciler) loadSource(
+	ctx context.Context, req ctrl.Request, source client.Object,
+) (*CopyConfig, ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 
-	// Get the secret
-	secret := &corev1.Secret{}
-	if err := r.Get(ctx, req.NamespacedName, secret); err != nil {
+	// Get the source
+	if err := r.Get(ctx, req.NamespacedName, source); err != nil {
 		if errors.IsNotFound(err) {
-			return ctrl.Result{}, nil
+			forgetSource(sourceKind(source), req.NamespacedName)
+			return nil, ctrl.Result{}, nil
 		}
-		return ctrl.Result{}, err
+		return nil, ctrl.Result{}, err
+	}
+
+	// Deleted source or copying disabled by removing the label: clean up copies if requested
+	if !source.GetDeletionTimestamp().IsZero() ||
+		(source.GetLabels()[LabelEnabled] != "true" && controllerutil.ContainsFinalizer(source, FinalizerCleanup)) {
+		result, err := r.reconcileCleanup(ctx, source)
+		return nil, result, err
+	}
+
+	// Copying disabled by removing the label, copies without finalizer are kept
+	if source.GetLabels()[LabelEnabled] != "true" {
+		forgetSource(sourceKind(source), req.NamespacedName)
+		return nil, ctrl.Result{}, nil
 	}
 
 	// Parse configuration from annotations
-	config, err := parseConfig(secret)
+	config, err := parseConfig(source)
 	if err != nil {
-		logger.Error(nil, "Invalid secret configuration", "reason", err.Error())
-		_, _ = r.updateStatusWithRetry(ctx, secret, StatusErrorPrefix+err.Error(), false)
+		logger.Error(nil, "Invalid source configuration", "reason", err.Error())
+		r.recordEvent(source, corev1.EventTypeWarning, EventReasonSyncFailed, "Invalid configuration: %s", err.Error())
+		_, _ = r.updateStatusWithRetry(ctx, source, StatusErrorPrefix+err.Error(), false)
+		return nil, ctrl.Result{}, nil
+	}
+
+	if err := checkSensitiveKeys(source, config); err != nil {
+		logger.Error(nil, "Refusing to convert source", "reason", err.Error())
+		r.recordEvent(source, corev1.EventTypeWarning, EventReasonSyncFailed, "%s", err.Error())
+		_, _ = r.updateStatusWithRetry(ctx, source, StatusErrorPrefix+err.Error(), false)
+		return nil, ctrl.Result{}, nil
+	}
+
+	// Data templates or a destination type the current source data cannot satisfy would fail for every destination
+	if _, err := r.prepareContent(source, config); err != nil {
+		logger.Error(nil, "Invalid source data", "reason", err.Error())
+		r.recordEvent(source, corev1.EventTypeWarning, EventReasonSyncFailed, "%s", err.Error())
+		_, _ = r.updateStatusWithRetry(ctx, source, StatusErrorPrefix+err.Error(), false)
+		return nil, ctrl.Result{}, nil
+	}
+
+	if err := r.ensureFinalizer(ctx, source, config.DeletionPolicy); err != nil {
+		return nil, ctrl.Result{}, err
+	}
+
+	return config, ctrl.Result{}, nil
+}
+
+// destinationSelectors describes the label selectors of destination clusters for status messages
+func destinationSelectors(config *CopyConfig) string {
+	var selectors []string
+	if config.DstClusterSelector != nil {
+		selectors = append(selectors, config.DstClusterSelector.String())
+	}
+	if config.DstClusterAPISelector != nil {
+		selectors = append(selectors, "Cluster API: "+config.DstClusterAPISelector.String())
+	}
+	return strings.Join(selectors, "; ")
+}
+
+// resyncPeriod returns the per-secret resync period if set, otherwise the operator default.
+// Sources selecting destination namespaces are resynced every namespaceRescanPeriod if resync
+// is disabled to pick up new namespaces in remote clusters.
+func (r *SecretCopyReconciler) resyncPeriod(config *CopyConfig) time.Duration {
+	period := r.ResyncPeriod
+	if config.ResyncPeriod != nil {
+		period = *config.ResyncPeriod
+	}
+	if config.DstNamespaceSelector != nil && period == 0 {
+		return namespaceRescanPeriod
+	}
+	return period
+}
+
+// pendingResyncPeriod returns when to recheck clusters waiting for a control plane. The Cluster API
+// watch enqueues the source once a control plane becomes ready, without it the source is polled
+// every clusterAPIPollPeriod or on resync if that is sooner.
+func (r *SecretCopyReconciler) pendingResyncPeriod(config *CopyConfig) time.Duration {
+	period := r.resyncPeriod(config)
+	if !r.WatchClusterAPI && (period == 0 || period > clusterAPIPollPeriod) {
+		return clusterAPIPollPeriod
+	}
+	return period
+}
+
+// reconcileCleanup removes copies from destination clusters when deletionPolicy=Delete
+// and releases the source secret by removing the finalizer. It handles both deletion
+// of the source and removal of the enable label.
+func (r *SecretCopyReconciler) reconcileCleanup(ctx context.Context, source client.Object) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	if !controllerutil.ContainsFinalizer(source, FinalizerCleanup) {
 		return ctrl.Result{}, nil
 	}
 
-	logger.Info("Reconciling secret",
-		"secret", req.NamespacedName,
-		"dstKubeconfig", config.DstKubeconfigRef,
-		"dstNamespace", config.DstNamespace,
-	)
+	config, err := parseConfig(source)
+	if err != nil {
+		// Policy is unknown without a valid configuration, never delete copies blindly
+		logger.Error(nil, "Invalid source configuration, orphaning copies", "reason", err.Error())
+		return ctrl.Result{}, r.releaseSource(ctx, source)
+	}
+
+	if config.DeletionPolicy == DeletionPolicyDelete {
+		targets := getSyncedTargets(source)
+
+		// Include current destinations in case a copy was written but not recorded
+		destinations, _, err := r.resolveDestinations(ctx, config)
+		if err != nil {
+			logger.Error(err, "Failed to resolve destination clusters, using recorded targets only")
+		}
+		for _, ref := range destinations {
+			for _, namespace := range config.listedNamespaces() {
+				if target := newSyncTarget(ref, config.forNamespace(namespace)); !slices.Contains(targets, target) {
+					targets = append(targets, target)
+				}
+			}
+		}
+
+		var remaining []syncTarget
+		var deleteErrors []string
+		for _, target := range targets {
+			if err := r.deleteTarget(ctx, source, target); err != nil {
+				deleteErrors = append(deleteErrors, fmt.Sprintf("%s: %s", target, err.Error()))
+				remaining = append(remaining, target)
+			}
+		}
+
+		if len(deleteErrors) > 0 {
+			if err := r.updateSyncedTargets(ctx, source, remaining); err != nil {
+				logger.Error(err, "Failed to record synced targets")
+			}
+			delay, _ := r.updateStatusWithRetry(ctx, source, StatusErrorPrefix+strings.Join(deleteErrors, "; "), true)
+			logger.Info("Scheduling cleanup retry", "delay", delay, "errors", len(deleteErrors))
+			return ctrl.Result{RequeueAfter: delay}, nil
+		}
+	}
+
+	logger.Info("Releasing source", "deletionPolicy", config.DeletionPolicy)
+	return ctrl.Result{}, r.releaseSource(ctx, source)
+}
+
+// releaseSource removes the cleanup finalizer and forgets synced targets
+func (r *SecretCopyReconciler) releaseSource(ctx context.Context, source client.Object) error {
+	patch := client.MergeFrom(source.DeepCopyObject().(client.Object))
+	controllerutil.RemoveFinalizer(source, FinalizerCleanup)
+	delete(source.GetAnnotations(), AnnotationSyncedTargets)
+	if err := r.Patch(ctx, source, patch); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to release source %s: %w", sourceKind(source), err)
+	}
+	forgetSource(sourceKind(source), client.ObjectKeyFromObject(source))
+	return nil
+}
+
+// updateSyncedTargets records synced targets in the status annotation if they changed
+func (r *SecretCopyReconciler) updateSyncedTargets(ctx context.Context, source client.Object, targets []syncTarget) error {
+	value := formatSyncedTargets(targets)
+	if source.GetAnnotations()[AnnotationSyncedTargets] == value {
+		return nil
+	}
+
+	patch := client.MergeFrom(source.DeepCopyObject().(client.Object))
+	annotations := source.GetAnnotations()
+	if value == "" {
+		delete(annotations, AnnotationSyncedTargets)
+	} else {
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+		annotations[AnnotationSyncedTargets] = value
+	}
+	source.SetAnnotations(annotations)
+	return r.Patch(ctx, source, patch)
+}
+
+// ensureFinalizer adds the cleanup finalizer for deletionPolicy=Delete and removes it otherwise
+func (r *SecretCopyReconciler) ensureFinalizer(ctx context.Context, source client.Object, policy DeletionPolicy) error {
+	if policy != DeletionPolicyDelete {
+		return r.removeFinalizer(ctx, source)
+	}
+	if controllerutil.ContainsFinalizer(source, FinalizerCleanup) {
+		return nil
+	}
+
+	patch := client.MergeFrom(source.DeepCopyObject().(client.Object))
+	controllerutil.AddFinalizer(source, FinalizerCleanup)
+	if err := r.Patch(ctx, source, patch); err != nil {
+		return fmt.Errorf("failed to add finalizer: %w", err)
+	}
+	return nil
+}
+
+// removeFinalizer removes the cleanup finalizer if present
+func (r *SecretCopyReconciler) removeFinalizer(ctx context.Context, source client.Object) error {
+	if !controllerutil.ContainsFinalizer(source, FinalizerCleanup) {
+		return nil
+	}
+
+	patch := client.MergeFrom(source.DeepCopyObject().(client.Object))
+	controllerutil.RemoveFinalizer(source, FinalizerCleanup)
+	if err := r.Patch(ctx, source, patch); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to remove finalizer: %w", err)
+	}
+	return nil
+}
+
+// resolveDestinations returns explicit kubeconfig references merged with kubeconfig
+// secrets matching the cluster selector and kubeconfig secrets of ready Cluster API
+// clusters, without duplicates. Cluster API clusters that are not ready are returned as pending.
+func (r *SecretCopyReconciler) resolveDestinations(
+	ctx context.Context,
+	config *CopyConfig,
+) (destinations, pending []types.NamespacedName, err error) {
+	destinations = make([]types.NamespacedName, 0, len(config.DstKubeconfigRefs))
+	seen := make(map[types.NamespacedName]bool)
+	for _, ref := range config.DstKubeconfigRefs {
+		seen[ref] = true
+		destinations = append(destinations, ref)
+	}
+
+	if len(config.DstClusterRefs) > 0 || config.DstClusterAPISelector != nil {
+		ready, waiting, err := r.resolveClusterAPIDestinations(ctx, config)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, ref := range ready {
+			if !seen[ref] {
+				seen[ref] = true
+				destinations = append(destinations, ref)
+			}
+		}
+		for _, ref := range waiting {
+			if !seen[ref] {
+				seen[ref] = true
+				pending = append(pending, ref)
+			}
+		}
+	}
+
+	if config.DstClusterSelector == nil {
+		return destinations, pending, nil
+	}
+
+	kubeconfigSecrets := &corev1.SecretList{}
+	if err := r.List(ctx, kubeconfigSecrets, client.MatchingLabelsSelector{Selector: config.DstClusterSelector}); err != nil {
+		return nil, nil, fmt.Errorf("failed to list kubeconfig secrets: %w", err)
+	}
+
+	selected := make([]types.NamespacedName, 0, len(kubeconfigSecrets.Items))
+	for i := range kubeconfigSecrets.Items {
+		// Source secrets and their in-cluster copies may share labels with kubeconfig secrets,
+		// never treat them as clusters
+		if kubeconfigSecrets.Items[i].Labels[LabelEnabled] == "true" || kubeconfigSecrets.Items[i].Labels[LabelCopy] == "true" {
+			continue
+		}
+		ref := client.ObjectKeyFromObject(&kubeconfigSecrets.Items[i])
+		if !seen[ref] {
+			seen[ref] = true
+			selected = append(selected, ref)
+		}
+	}
+	// List order is not guaranteed, keep status messages and logs stable
+	sort.Slice(selected, func(i, j int) bool {
+		return selected[i].String() < selected[j].String()
+	})
+
+	return append(destinations, selected...), pending, nil
+}
+
+// syncToCluster copies the source secret to the cluster referenced by kubeconfigRef
+func (r *SecretCopyReconciler) syncToCluster(
+	ctx context.Context,
+	source client.Object,
+	kubeconfigRef types.NamespacedName,
+	config *CopyConfig,
+) error {
+	targetClient, err := r.targetClientFor(ctx, source, kubeconfigRef)
+	if err != nil {
+		return err
+	}
+	return r.syncToNamespace(ctx, source, kubeconfigRef, targetClient, config)
+}
+
+// targetClientFor returns the client of the cluster referenced by kubeconfigRef
+func (r *SecretCopyReconciler) targetClientFor(
+	ctx context.Context,
+	source client.Object,
+	kubeconfigRef types.NamespacedName,
+) (client.Client, error) {
+	if isInCluster(kubeconfigRef) {
+		return r.Client, nil
+	}
+
+	cluster := clusterName(kubeconfigRef)
+	logger := log.FromContext(ctx).WithValues("cluster", cluster)
 
 	// Get kubeconfig secret
 	kubeconfigSecret := &corev1.Secret{}
-	if err := r.Get(ctx, config.DstKubeconfigRef, kubeconfigSecret); err != nil {
-		logger.Error(nil, "Kubeconfig secret not found", "ref", config.DstKubeconfigRef)
-		delay, _ := r.updateStatusWithRetry(ctx, secret, StatusErrorPrefix+"kubeconfig not found", true)
-		return ctrl.Result{RequeueAfter: delay}, nil
+	if err := r.Get(ctx, kubeconfigRef, kubeconfigSecret); err != nil {
+		syncAttempts.WithLabelValues(cluster).Inc()
+		logger.Error(nil, "Kubeconfig secret not found", "ref", kubeconfigRef)
+		if errors.IsNotFound(err) {
+			syncFailures.WithLabelValues(cluster, errorClassKubeconfigNotFound).Inc()
+			r.recordEvent(source, corev1.EventTypeWarning, EventReasonKubeconfigNotFound,
+				"Kubeconfig secret %s not found", kubeconfigRef)
+		} else {
+			syncFailures.WithLabelValues(cluster, errorClassAPI).Inc()
+		}
+		return nil, fmt.Errorf("kubeconfig not found")
 	}
 
 	targetClient, err := r.ClusterClientGetter.GetClient(kubeconfigSecret)
 	if err != nil {
+		syncAttempts.WithLabelValues(cluster).Inc()
+		syncFailures.WithLabelValues(cluster, errorClassKubeconfigInvalid).Inc()
 		logger.Error(err, "Failed to create target client")
-		delay, _ := r.updateStatusWithRetry(ctx, secret, StatusErrorPrefix+err.Error(), true)
-		logger.Info("Scheduling retry", "delay", delay)
-		return ctrl.Result{RequeueAfter: delay}, nil
+		return nil, err
 	}
+	return targetClient, nil
+}
 
-	if err := r.copySecret(ctx, secret, targetClient, config); err != nil {
-		logger.Error(err, "Failed to copy secret")
-		delay, _ := r.updateStatusWithRetry(ctx, secret, StatusErrorPrefix+err.Error(), true)
-		logger.Info("Scheduling retry", "delay", delay)
-		return ctrl.Result{RequeueAfter: delay}, nil
+// syncToNamespace copies the source to config.DstNamespace with the client of the cluster referenced by kubeconfigRef
+func (r *SecretCopyReconciler) syncToNamespace(
+	ctx context.Context,
+	source client.Object,
+	kubeconfigRef types.NamespacedName,
+	targetClient client.Client,
+	config *CopyConfig,
+) error {
+	cluster := clusterName(kubeconfigRef)
+	logger := log.FromContext(ctx).WithValues("cluster", cluster)
+	syncAttempts.WithLabelValues(cluster).Inc()
+
+	// Never overwrite the source with its own copy
+	if isInCluster(kubeconfigRef) && destinationKind(source, config) == sourceKind(source) &&
+		config.DstNamespace == source.GetNamespace() && config.DstSecretName == source.GetName() {
+		syncFailures.WithLabelValues(cluster, errorClassAPI).Inc()
+		return fmt.Errorf("refusing to copy %s %s/%s onto itself", sourceKind(source), source.GetNamespace(), source.GetName())
 	}
 
-	logger.Info("Secret copied successfully",
-		"dst", config.DstNamespace+"/"+secret.Name,
+	dst := config.DstNamespace + "/" + config.DstSecretName
+	start := time.Now()
+	result, err := r.copyObject(ctx, source, targetClient, config)
+	syncDuration.WithLabelValues(cluster).Observe(time.Since(start).Seconds())
+	if err != nil {
+		if result == copyNamespaceMissing {
+			syncFailures.WithLabelValues(cluster, errorClassNamespaceMissing).Inc()
+			r.recordEvent(source, corev1.EventTypeWarning, EventReasonTargetNamespaceMissing,
+				"Namespace %s does not exist in cluster %s", config.DstNamespace, cluster)
+		} else {
+			syncFailures.WithLabelValues(cluster, errorClassAPI).Inc()
+		}
+		logger.Error(err, "Failed to copy source")
+		return err
+	}
+	syncSuccesses.WithLabelValues(cluster).Inc()
+
+	switch result {
+	case copyCreated, copyUpdated:
+		r.recordEvent(source, corev1.EventTypeNormal, EventReasonSynced,
+			"Copied to %s in cluster %s", dst, cluster)
+	case copySkipped:
+		r.recordEvent(source, corev1.EventTypeNormal, EventReasonSkippedExisting,
+			"%s %s already exists in cluster %s, strategy=ignore", destinationKind(source, config), dst, cluster)
+	}
+
+	logger.Info("Copied successfully",
+		"dst", dst,
 		"fields", len(config.FieldsMapping),
 	)
+	return nil
+}
+
+// recordEvent records an Event on obj if an event recorder is configured
+func (r *SecretCopyReconciler) recordEvent(obj runtime.Object, eventType, reason, messageFmt string, args ...any) {
+	if r.Recorder == nil {
+		return
+	}
+	r.Recorder.Eventf(obj, eventType, reason, messageFmt, args...)
+}
 
-	_, _ = r.updateStatusWithRetry(ctx, secret, StatusSynced, false)
-	return ctrl.Result{}, nil
+// deleteTarget removes the copy of the source secret described by target
+func (r *SecretCopyReconciler) deleteTarget(ctx context.Context, source client.Object, target syncTarget) error {
+	logger := log.FromContext(ctx).WithValues("cluster", target.Cluster)
+
+	kubeconfigRef, err := target.kubeconfigRef()
+	if err != nil {
+		return err
+	}
+
+	if isInCluster(kubeconfigRef) {
+		return r.deleteCopy(ctx, source, target.copyKind(source), r.Client, types.NamespacedName{
+			Namespace: target.Namespace,
+			Name:      target.Name,
+		})
+	}
+
+	kubeconfigSecret := &corev1.Secret{}
+	if err := r.Get(ctx, kubeconfigRef, kubeconfigSecret); err != nil {
+		if errors.IsNotFound(err) {
+			// Cluster is no longer registered, its copy cannot be reached anymore
+			logger.Info("Kubeconfig secret not found, skipping cleanup")
+			return nil
+		}
+		return fmt.Errorf("failed to get kubeconfig secret: %w", err)
+	}
+
+	targetClient, err := r.ClusterClientGetter.GetClient(kubeconfigSecret)
+	if err != nil {
+		logger.Error(err, "Failed to create target client")
+		return err
+	}
+
+	return r.deleteCopy(ctx, source, target.copyKind(source), targetClient, types.NamespacedName{
+		Namespace: target.Namespace,
+		Name:      target.Name,
+	})
+}
+
+// deleteCopy deletes the object of the kind in the target cluster if it is a copy of source
+func (r *SecretCopyReconciler) deleteCopy(
+	ctx context.Context,
+	source client.Object,
+	kind string,
+	targetClient client.Client,
+	key types.NamespacedName,
+) error {
+	logger := log.FromContext(ctx)
+
+	existing := newObject(kind)
+	if err := targetClient.Get(ctx, key, existing); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to check existing %s: %w", kind, err)
+	}
+
+	// Never delete objects which were not created from this source
+	if !r.isCopyOf(existing, source) {
+		logger.Info("Destination is not a copy of source, skipping deletion", "dst", key)
+		return nil
+	}
+
+	if err := targetClient.Delete(ctx, existing); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete copied %s: %w", kind, err)
+	}
+
+	logger.Info("Copy deleted", "kind", kind, "dst", key)
+	return nil
 }
 
-func (r *SecretCopyReconciler) copySecret(
+// createNamespace creates the destination namespace with the configured labels and annotations
+func (r *SecretCopyReconciler) createNamespace(
 	ctx context.Context,
-	source *corev1.Secret,
+	source client.Object,
 	targetClient client.Client,
 	config *CopyConfig,
 ) error {
+	annotations := maps.Clone(config.NamespaceAnnotations)
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	r.setCopyAnnotations(annotations, source)
+
+	ns := &corev1.Namespace{}
+	ns.Name = config.DstNamespace
+	ns.Labels = maps.Clone(config.NamespaceLabels)
+	ns.Annotations = annotations
+	if err := targetClient.Create(ctx, ns); err != nil {
+		// Another source may have created it meanwhile
+		if errors.IsAlreadyExists(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to create namespace %q: %w", config.DstNamespace, err)
+	}
+
+	log.FromContext(ctx).Info("Target namespace created", "namespace", config.DstNamespace)
+	r.recordEvent(source, corev1.EventTypeNormal, EventReasonNamespaceCreated, "Created namespace %s", config.DstNamespace)
+	return nil
+}
+
+// isCopyOf returns true if target was copied from source by this cluster
+func (r *SecretCopyReconciler) isCopyOf(target, source client.Object) bool {
+	return target.GetAnnotations()[AnnotationSourceSecret] == source.GetNamespace()+"/"+source.GetName() &&
+		target.GetAnnotations()[AnnotationSourceCluster] == r.ClusterName
+}
+
+// copyObject creates or updates the copy of the source Secret or ConfigMap in the target cluster
+// and reports what was done
+func (r *SecretCopyReconciler) copyObject(
+	ctx context.Context,
+	source client.Object,
+	targetClient client.Client,
+	config *CopyConfig,
+) (copyResult, error) {
 	logger := log.FromContext(ctx)
 
 	ns := &corev1.Namespace{}
 	if err := targetClient.Get(ctx, types.NamespacedName{Name: config.DstNamespace}, ns); err != nil {
-		if errors.IsNotFound(err) {
+		if !errors.IsNotFound(err) {
+			return 0, fmt.Errorf("failed to check namespace existence: %w", err)
+		}
+		if !config.CreateNamespace {
 			logger.Error(nil, "Target namespace does not exist", "namespace", config.DstNamespace)
-			return fmt.Errorf("target namespace %q does not exist in destination cluster", config.DstNamespace)
+			return copyNamespaceMissing, fmt.Errorf("target namespace %q does not exist in destination cluster", config.DstNamespace)
+		}
+		if err := r.createNamespace(ctx, source, targetClient, config); err != nil {
+			return 0, err
 		}
-		return fmt.Errorf("failed to check namespace existence: %w", err)
 	}
 
-	existing := &corev1.Secret{}
+	kind := destinationKind(source, config)
+	existing := newObject(kind)
 	err := targetClient.Get(ctx, types.NamespacedName{
 		Namespace: config.DstNamespace,
 		Name:      config.DstSecretName,
 	}, existing)
 
-	secretExists := err == nil
+	exists := err == nil
 	if err != nil && !errors.IsNotFound(err) {
-		return fmt.Errorf("failed to check existing secret: %w", err)
+		return 0, fmt.Errorf("failed to check existing %s: %w", kind, err)
 	}
 
-	if secretExists && config.Strategy == StrategyIgnore {
-		log.FromContext(ctx).Info("Secret exists, strategy=ignore, skipping")
-		return nil
+	// Copies of different sources rendered to the same name would overwrite each other on every sync
+	if exists && existing.GetLabels()[LabelCopy] == "true" && existing.GetAnnotations()[AnnotationSourceSecret] != "" &&
+		!r.isCopyOf(existing, source) {
+		return 0, fmt.Errorf("%s %s/%s is already a copy of %s", kind, config.DstNamespace, config.DstSecretName,
+			existing.GetAnnotations()[AnnotationSourceSecret])
 	}
 
-	data := r.prepareData(source.Data, config.FieldsMapping)
-	secretType := r.resolveSecretType(source.Type, config.DstType)
+	if exists && config.Strategy == StrategyIgnore {
+		log.FromContext(ctx).Info("Destination exists, strategy=ignore, skipping")
+		return copySkipped, nil
+	}
 
-	if secretExists {
-		existing.Data = data
-		existing.Type = secretType
+	content, err := r.prepareContent(source, config)
+	if err != nil {
+		return 0, err
+	}
 
+	if exists {
 		// Merge filtered source annotations into existing
-		filteredAnnotations := filterAnnotationsForCopy(source.Annotations)
-		if existing.Annotations == nil {
-			existing.Annotations = make(map[string]string)
+		filteredAnnotations := filterAnnotationsForCopy(source.GetAnnotations())
+		if len(config.OwnerAnnotations) > 0 {
+			if filteredAnnotations == nil {
+				filteredAnnotations = make(map[string]string)
+			}
+			maps.Copy(filteredAnnotations, config.OwnerAnnotations)
+		}
+		if config.Strategy == StrategyMerge {
+			if filteredAnnotations == nil {
+				filteredAnnotations = make(map[string]string)
+			}
+			filteredAnnotations[AnnotationManagedKeys] = strings.Join(content.keys(), ",")
+			content = content.mergedWith(existing, managedKeys(existing))
+		}
+
+		// Avoid rewriting the copy (and bumping copiedAt) on resync when nothing drifted
+		if r.copyUpToDate(existing, source, content, filteredAnnotations) {
+			logger.V(1).Info("Destination is up to date, skipping update")
+			return copyUnchanged, nil
+		}
+
+		content.applyTo(existing)
+		existingLabels := existing.GetLabels()
+		if existingLabels == nil {
+			existingLabels = make(map[string]string)
+		}
+		existingLabels[LabelCopy] = "true"
+		existing.SetLabels(existingLabels)
+		existingAnnotations := existing.GetAnnotations()
+		if existingAnnotations == nil {
+			existingAnnotations = make(map[string]string)
 		}
 		for k, v := range filteredAnnotations {
-			existing.Annotations[k] = v
+			existingAnnotations[k] = v
 		}
-		r.setCopyAnnotations(existing.Annotations, source)
+		if config.Strategy != StrategyMerge {
+			// Keys recorded by an earlier merge are all overwritten now
+			delete(existingAnnotations, AnnotationManagedKeys)
+		}
+		r.setCopyAnnotations(existingAnnotations, source)
+		existing.SetAnnotations(existingAnnotations)
 
-		return targetClient.Update(ctx, existing)
+		return copyUpdated, targetClient.Update(ctx, existing)
 	}
 
-	annotations := filterAnnotationsForCopy(source.Annotations)
+	annotations := filterAnnotationsForCopy(source.GetAnnotations())
 	if annotations == nil {
 		annotations = make(map[string]string)
 	}
 	r.setCopyAnnotations(annotations, source)
+	maps.Copy(annotations, config.OwnerAnnotations)
+	if config.Strategy == StrategyMerge {
+		annotations[AnnotationManagedKeys] = strings.Join(content.keys(), ",")
+	}
 
-	newSecret := &corev1.Secret{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:        config.DstSecretName,
-			Namespace:   config.DstNamespace,
-			Labels:      r.filterLabels(source.Labels),
-			Annotations: annotations,
-		},
-		Type: secretType,
-		Data: data,
+	copyLabels := r.filterLabels(source.GetLabels())
+	if copyLabels == nil {
+		copyLabels = make(map[string]string)
 	}
+	copyLabels[LabelCopy] = "true"
+
+	newCopy := newObject(kind)
+	newCopy.SetName(config.DstSecretName)
+	newCopy.SetNamespace(config.DstNamespace)
+	newCopy.SetLabels(copyLabels)
+	newCopy.SetAnnotations(annotations)
+	content.applyTo(newCopy)
 
-	return targetClient.Create(ctx, newSecret)
+	return copyCreated, targetClient.Create(ctx, newCopy)
 }
 
-// setCopyAnnotations sets standard annotations on copied secret
-func (r *SecretCopyReconciler) setCopyAnnotations(annotations map[string]string, source *corev1.Secret) {
-	annotations["secret-copy.in-cloud.io/sourceCluster"] = r.ClusterName
-	annotations["secret-copy.in-cloud.io/sourceSecret"] = source.Namespace + "/" + source.Name
-	annotations["secret-copy.in-cloud.io/copiedAt"] = time.Now().UTC().Format(time.RFC3339)
+// copyUpToDate returns true if the existing copy already matches the desired state
+func (r *SecretCopyReconciler) copyUpToDate(
+	existing, source client.Object,
+	content copyContent,
+	annotations map[string]string,
+) bool {
+	if !content.matches(existing) || !r.isCopyOf(existing, source) || copySourceKind(existing) != sourceKind(source) {
+		return false
+	}
+	if existing.GetLabels()[LabelCopy] != "true" {
+		return false
+	}
+	// Managed keys left by an earlier merge must be dropped, a later merge would delete keys by them
+	if _, stale := existing.GetAnnotations()[AnnotationManagedKeys]; stale {
+		if _, managed := annotations[AnnotationManagedKeys]; !managed {
+			return false
+		}
+	}
+	for k, v := range annotations {
+		if existing.GetAnnotations()[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// dataEqual compares Secret or ConfigMap data treating nil and empty maps as equal
+func dataEqual[V any](a, b map[string]V) bool {
+	if len(a) == 0 && len(b) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// setCopyAnnotations sets standard annotations on the copy
+func (r *SecretCopyReconciler) setCopyAnnotations(annotations map[string]string, source client.Object) {
+	annotations[AnnotationSourceCluster] = r.ClusterName
+	annotations[AnnotationSourceSecret] = source.GetNamespace() + "/" + source.GetName()
+	annotations[AnnotationSourceKind] = sourceKind(source)
+	annotations[AnnotationCopiedAt] = time.Now().UTC().Format(time.RFC3339)
 }
 
 // resolveSecretType returns dstType if set, otherwise uses sourceType
@@ -196,24 +885,9 @@ func (r *SecretCopyReconciler) resolveSecretType(sourceType, dstType corev1.Secr
 	return sourceType
 }
 
-// prepareData prepares data considering field mapping
-func (r *SecretCopyReconciler) prepareData(sourceData map[string][]byte, fieldsMapping map[string]string) map[string][]byte {
-	if len(fieldsMapping) == 0 {
-		result := make(map[string][]byte, len(sourceData))
-		for k, v := range sourceData {
-			result[k] = v
-		}
-		return result
-	}
-
-	result := make(map[string][]byte, len(fieldsMapping))
-	for srcKey, dstKey := range fieldsMapping {
-		if value, ok := sourceData[srcKey]; ok {
-			result[dstKey] = value
-		}
-	}
-
-	return result
+// prepareData prepares data considering field mapping and key selection rules
+func (r *SecretCopyReconciler) prepareData(sourceData map[string][]byte, config *CopyConfig) map[string][]byte {
+	return applyKeyRules(sourceData, config)
 }
 
 // filterLabels removes service labels
@@ -260,25 +934,29 @@ func filterAnnotationsForCopy(annotations map[string]string) map[string]string {
 // updateStatusWithRetry updates status annotations and manages retry count for exponential backoff.
 // If incrementRetry is true, increments retry count and returns calculated delay.
 // If incrementRetry is false (success), resets retry count.
-func (r *SecretCopyReconciler) updateStatusWithRetry(ctx context.Context, secret *corev1.Secret, status string, incrementRetry bool) (time.Duration, error) {
-	patch := client.MergeFrom(secret.DeepCopy())
+func (r *SecretCopyReconciler) updateStatusWithRetry(ctx context.Context, source client.Object, status string, incrementRetry bool) (time.Duration, error) {
+	patch := client.MergeFrom(source.DeepCopyObject().(client.Object))
 
-	if secret.Annotations == nil {
-		secret.Annotations = make(map[string]string)
+	annotations := source.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
 	}
-	secret.Annotations[AnnotationLastSyncTime] = time.Now().UTC().Format(time.RFC3339)
-	secret.Annotations[AnnotationLastSyncStatus] = status
+	annotations[AnnotationLastSyncTime] = time.Now().UTC().Format(time.RFC3339)
+	annotations[AnnotationLastSyncStatus] = status
 
 	var delay time.Duration
 	if incrementRetry {
-		retryCount := getRetryCount(secret)
+		retryCount := getRetryCount(source)
 		delay = calculateBackoff(retryCount)
-		secret.Annotations[AnnotationRetryCount] = strconv.Itoa(retryCount + 1)
+		annotations[AnnotationRetryCount] = strconv.Itoa(retryCount + 1)
+		recordSourceRetry(sourceKind(source), client.ObjectKeyFromObject(source), retryCount+1)
 	} else {
-		delete(secret.Annotations, AnnotationRetryCount)
+		delete(annotations, AnnotationRetryCount)
+		recordSourceRetry(sourceKind(source), client.ObjectKeyFromObject(source), 0)
 	}
+	source.SetAnnotations(annotations)
 
-	return delay, r.Patch(ctx, secret, patch)
+	return delay, r.Patch(ctx, source, patch)
 }
 
 // filterStatusAnnotations returns annotations without status.secret-copy.in-cloud.io/* keys
@@ -295,57 +973,294 @@ func filterStatusAnnotations(annotations map[string]string) map[string]string {
 	return result
 }
 
-// secretSpecChanged returns true if secret data, labels, or config annotations changed
-func secretSpecChanged(oldObj, newObj client.Object) bool {
-	oldSecret, ok1 := oldObj.(*corev1.Secret)
-	newSecret, ok2 := newObj.(*corev1.Secret)
-	if !ok1 || !ok2 {
-		return true
+// findSourcesOfKindForKubeconfig maps a kubeconfig secret to the sources of the list kind
+// that reference it explicitly, select it with dstClusterSelector or reference its Cluster API cluster
+func (r *SecretCopyReconciler) findSourcesOfKindForKubeconfig(
+	ctx context.Context,
+	sources client.ObjectList,
+	obj client.Object,
+) []reconcile.Request {
+	kubeconfigRef := client.ObjectKeyFromObject(obj)
+	kubeconfigLabels := labels.Set(obj.GetLabels())
+	clusterAPICluster, isClusterAPIKubeconfig := clusterAPIClusterForKubeconfig(kubeconfigRef)
+
+	destinations := []string{kubeconfigDestination(kubeconfigRef), destinationKubeconfigSelector}
+	if isClusterAPIKubeconfig {
+		destinations = append(destinations, clusterAPIDestination(clusterAPICluster))
 	}
 
-	if !reflect.DeepEqual(oldSecret.Data, newSecret.Data) {
-		return true
+	return r.findSources(ctx, sources, destinations, func(source client.Object, config *CopyConfig) bool {
+		if sourceKind(source) == KindSecret && client.ObjectKeyFromObject(source) == kubeconfigRef {
+			return false
+		}
+		return slices.Contains(config.DstKubeconfigRefs, kubeconfigRef) ||
+			(config.DstClusterSelector != nil && config.DstClusterSelector.Matches(kubeconfigLabels)) ||
+			(isClusterAPIKubeconfig && slices.Contains(config.DstClusterRefs, clusterAPICluster))
+	})
+}
+
+// findSources lists labeled sources indexed under any of the destinations into the list
+// and enqueues those with a valid configuration accepted by matches
+func (r *SecretCopyReconciler) findSources(
+	ctx context.Context,
+	sources client.ObjectList,
+	destinations []string,
+	matches func(source client.Object, config *CopyConfig) bool,
+) []reconcile.Request {
+	logger := log.FromContext(ctx)
+
+	var requests []reconcile.Request
+	seen := make(map[types.NamespacedName]bool)
+	for _, destination := range destinations {
+		if err := r.List(ctx, sources, client.MatchingLabels{LabelEnabled: "true"},
+			client.MatchingFields{sourceDestinationsIndex: destination}); err != nil {
+			logger.Error(err, "Failed to list sources")
+			return nil
+		}
+		items, err := meta.ExtractList(sources)
+		if err != nil {
+			logger.Error(err, "Failed to read sources")
+			return nil
+		}
+
+		for _, item := range items {
+			source, ok := item.(client.Object)
+			if !ok || seen[client.ObjectKeyFromObject(source)] {
+				continue
+			}
+			seen[client.ObjectKeyFromObject(source)] = true
+
+			config, err := parseConfig(source)
+			if err != nil {
+				continue
+			}
+
+			if matches(source, config) {
+				requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(source)})
+			}
+		}
+	}
+
+	return requests
+}
+
+// indexSourceDestinations returns the destinations a labeled source references or selects,
+// the values of sourceDestinationsIndex
+func indexSourceDestinations(obj client.Object) []string {
+	if obj.GetLabels()[LabelEnabled] != "true" {
+		return nil
+	}
+	config, err := parseConfig(obj)
+	if err != nil {
+		return nil
+	}
+
+	var destinations []string
+	for _, ref := range config.DstKubeconfigRefs {
+		destinations = append(destinations, kubeconfigDestination(ref))
+	}
+	for _, ref := range config.DstClusterRefs {
+		destinations = append(destinations, clusterAPIDestination(ref))
+	}
+	if config.DstClusterSelector != nil {
+		destinations = append(destinations, destinationKubeconfigSelector)
+	}
+	if config.DstClusterAPISelector != nil {
+		destinations = append(destinations, destinationClusterAPISelector)
+	}
+	if config.DstNamespaceSelector != nil && slices.Contains(config.DstKubeconfigRefs, inClusterRef) {
+		destinations = append(destinations, destinationNamespaceSelector)
+	}
+	return destinations
+}
+
+// kubeconfigDestination returns the index value of sources referencing the kubeconfig secret
+func kubeconfigDestination(ref types.NamespacedName) string {
+	return "kubeconfig:" + ref.String()
+}
+
+// clusterAPIDestination returns the index value of sources referencing the Cluster API Cluster
+func clusterAPIDestination(ref types.NamespacedName) string {
+	return "cluster:" + ref.String()
+}
+
+// findSourceOfKindForCopy maps a copy reported by drift detection to its source if the source is of the kind
+func (r *SecretCopyReconciler) findSourceOfKindForCopy(_ context.Context, kind string, obj client.Object) []reconcile.Request {
+	annotations := obj.GetAnnotations()
+	if annotations[AnnotationSourceCluster] != r.ClusterName || copySourceKind(obj) != kind {
+		return nil
+	}
+	// Copies managed by SecretCopy or ClusterSecretCopy resources are re-verified by their resync
+	if annotations[AnnotationSecretCopy] != "" || annotations[AnnotationClusterSecretCopy] != "" {
+		return nil
 	}
 
-	if !reflect.DeepEqual(oldSecret.Labels, newSecret.Labels) {
+	parts := strings.SplitN(annotations[AnnotationSourceSecret], "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil
+	}
+
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: parts[0], Name: parts[1]}}}
+}
+
+// kubeconfigChanged returns true if labels, data or the kubeconfig key of a potential kubeconfig secret changed
+func kubeconfigChanged(oldObj, newObj client.Object) bool {
+	oldSecret, ok1 := oldObj.(*corev1.Secret)
+	newSecret, ok2 := newObj.(*corev1.Secret)
+	if !ok1 || !ok2 {
 		return true
 	}
 
-	// Compare annotations (excluding status.*)
-	oldAnnotations := filterStatusAnnotations(oldSecret.Annotations)
-	newAnnotations := filterStatusAnnotations(newSecret.Annotations)
-	return !reflect.DeepEqual(oldAnnotations, newAnnotations)
+	return !reflect.DeepEqual(oldSecret.Labels, newSecret.Labels) ||
+		!reflect.DeepEqual(oldSecret.Data, newSecret.Data) ||
+		oldSecret.Annotations[AnnotationKubeconfigKey] != newSecret.Annotations[AnnotationKubeconfigKey]
 }
 
 // SetupWithManager sets up the controller with the Manager
 func (r *SecretCopyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return r.setupSourceController(mgr, &corev1.Secret{}, func() client.ObjectList {
+		return &corev1.SecretList{}
+	}, r)
+}
+
+// setupSourceController sets up a controller for labeled sources of the kind of source,
+// newSources returns an empty list of that kind for mapping kubeconfig and cluster events
+func (r *SecretCopyReconciler) setupSourceController(
+	mgr ctrl.Manager,
+	source client.Object,
+	newSources func() client.ObjectList,
+	reconciler reconcile.Reconciler,
+) error {
 	selector, err := labels.Parse(LabelEnabled + "=true")
 	if err != nil {
 		return fmt.Errorf("invalid label selector: %w", err)
 	}
 
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&corev1.Secret{}).
-		WithOptions(controller.Options{
-			MaxConcurrentReconciles: r.MaxConcurrentReconciles,
-		}).
-		WithEventFilter(predicate.Funcs{
+	// Cluster and namespace events look up the sources referencing them instead of parsing every source
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), source, sourceDestinationsIndex,
+		indexSourceDestinations); err != nil {
+		return fmt.Errorf("failed to index sources by destination: %w", err)
+	}
+
+	bldr := ctrl.NewControllerManagedBy(mgr).
+		For(source, builder.WithPredicates(predicate.Funcs{
 			CreateFunc: func(e event.CreateEvent) bool {
-				return selector.Matches(labels.Set(e.Object.GetLabels()))
+				return selector.Matches(labels.Set(e.Object.GetLabels())) ||
+					controllerutil.ContainsFinalizer(e.Object, FinalizerCleanup)
 			},
 			UpdateFunc: func(e event.UpdateEvent) bool {
+				// Sources holding the finalizer must be released on deletion or when the label is removed
+				if controllerutil.ContainsFinalizer(e.ObjectNew, FinalizerCleanup) &&
+					(!e.ObjectNew.GetDeletionTimestamp().IsZero() ||
+						!selector.Matches(labels.Set(e.ObjectNew.GetLabels()))) {
+					return true
+				}
 				if !selector.Matches(labels.Set(e.ObjectNew.GetLabels())) {
-					return false
+					// Metrics of a source that is no longer copied are dropped on reconcile
+					return selector.Matches(labels.Set(e.ObjectOld.GetLabels()))
 				}
 				// Ignore status-only updates to prevent reconcile loop
-				return secretSpecChanged(e.ObjectOld, e.ObjectNew)
+				return sourceSpecChanged(e.ObjectOld, e.ObjectNew)
 			},
+			// Copies are cleaned up through the finalizer while the object still exists,
+			// the reconcile of the deleted source only drops its metrics
 			DeleteFunc: func(e event.DeleteEvent) bool {
-				return false
+				return selector.Matches(labels.Set(e.Object.GetLabels()))
 			},
 			GenericFunc: func(e event.GenericEvent) bool {
 				return selector.Matches(labels.Set(e.Object.GetLabels()))
 			},
-		}).
-		Complete(r)
+		})).
+		// Kubeconfig secrets of destination clusters: a new or relabeled cluster
+		// enqueues every source secret that references or selects it
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(
+			func(ctx context.Context, obj client.Object) []reconcile.Request {
+				return r.findSourcesOfKindForKubeconfig(ctx, newSources(), obj)
+			}),
+			builder.WithPredicates(predicate.Funcs{
+				CreateFunc: func(e event.CreateEvent) bool {
+					return !selector.Matches(labels.Set(e.Object.GetLabels()))
+				},
+				UpdateFunc: func(e event.UpdateEvent) bool {
+					if selector.Matches(labels.Set(e.ObjectNew.GetLabels())) {
+						return false
+					}
+					return kubeconfigChanged(e.ObjectOld, e.ObjectNew)
+				},
+				DeleteFunc: func(e event.DeleteEvent) bool {
+					return false
+				},
+				GenericFunc: func(e event.GenericEvent) bool {
+					return false
+				},
+			})).
+		// New or relabeled namespaces may start or stop matching dstNamespaceSelector of in-cluster copies
+		Watches(&corev1.Namespace{}, handler.EnqueueRequestsFromMapFunc(
+			func(ctx context.Context, obj client.Object) []reconcile.Request {
+				return r.findSourcesOfKindForNamespace(ctx, newSources(), obj)
+			}),
+			builder.WithPredicates(predicate.Funcs{
+				UpdateFunc: func(e event.UpdateEvent) bool {
+					return !reflect.DeepEqual(e.ObjectOld.GetLabels(), e.ObjectNew.GetLabels())
+				},
+				DeleteFunc: func(e event.DeleteEvent) bool {
+					return false
+				},
+				GenericFunc: func(e event.GenericEvent) bool {
+					return false
+				},
+			})).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: r.MaxConcurrentReconciles,
+		})
+
+	// Cluster API Clusters becoming ready enqueue the sources that reference or select them
+	if r.WatchClusterAPI {
+		bldr = bldr.Watches(newClusterAPICluster(), handler.EnqueueRequestsFromMapFunc(
+			func(ctx context.Context, obj client.Object) []reconcile.Request {
+				return r.findSourcesOfKindForClusterAPICluster(ctx, newSources(), obj)
+			}),
+			builder.WithPredicates(predicate.Funcs{
+				UpdateFunc: func(e event.UpdateEvent) bool {
+					return clusterAPIClusterChanged(e.ObjectOld, e.ObjectNew)
+				},
+				DeleteFunc: func(e event.DeleteEvent) bool {
+					return false
+				},
+				GenericFunc: func(e event.GenericEvent) bool {
+					return false
+				},
+			}))
+	}
+
+	// Secret copies modified or deleted in destination clusters enqueue their source,
+	// in-cluster copies are watched through the manager's own cache
+	if r.DriftEvents != nil {
+		copySelector, err := labels.Parse(LabelCopy + "=true")
+		if err != nil {
+			return fmt.Errorf("invalid label selector: %w", err)
+		}
+		kind := sourceKind(source)
+		findSource := handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+			return r.findSourceOfKindForCopy(ctx, kind, obj)
+		})
+		bldr = bldr.WatchesRawSource(ctrlsource.Channel(r.DriftEvents, findSource)).
+			Watches(&corev1.Secret{}, findSource,
+				builder.WithPredicates(predicate.Funcs{
+					CreateFunc: func(e event.CreateEvent) bool {
+						return false
+					},
+					UpdateFunc: func(e event.UpdateEvent) bool {
+						return copySelector.Matches(labels.Set(e.ObjectOld.GetLabels()))
+					},
+					DeleteFunc: func(e event.DeleteEvent) bool {
+						return copySelector.Matches(labels.Set(e.Object.GetLabels()))
+					},
+					GenericFunc: func(e event.GenericEvent) bool {
+						return false
+					},
+				}))
+	}
+
+	return bldr.Complete(reconciler)
 }
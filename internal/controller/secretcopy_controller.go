@@ -0,0 +1,418 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	secretcopyv1alpha1 "secret-copy-operator/api/v1alpha1"
+)
+
+// SecretCopyResourceReconciler reconciles a SecretCopy object.
+// It shares the copy logic with SecretCopyReconciler, only the configuration
+// and status live in the custom resource instead of source secret annotations.
+type SecretCopyResourceReconciler struct {
+	SecretCopyReconciler
+}
+
+// +kubebuilder:rbac:groups=secret-copy.in-cloud.io,resources=secretcopies,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=secret-copy.in-cloud.io,resources=secretcopies/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=secret-copy.in-cloud.io,resources=secretcopies/finalizers,verbs=update
+
+func (r *SecretCopyResourceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	secretCopy := &secretcopyv1alpha1.SecretCopy{}
+	if err := r.Get(ctx, req.NamespacedName, secretCopy); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !secretCopy.DeletionTimestamp.IsZero() {
+		return r.reconcileResourceCleanup(ctx, secretCopy)
+	}
+
+	config, err := configFromSpec(secretCopy)
+	if err != nil {
+		logger.Error(nil, "Invalid SecretCopy spec", "reason", err.Error())
+		return ctrl.Result{}, r.updateResourceStatus(ctx, secretCopy, secretcopyv1alpha1.ReasonInvalidSpec, err.Error())
+	}
+
+	if err := r.ensureResourceFinalizer(ctx, secretCopy, config.DeletionPolicy); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	source := &corev1.Secret{}
+	sourceKey := types.NamespacedName{Namespace: secretCopy.Namespace, Name: secretCopy.Spec.Source.Name}
+	if err := r.Get(ctx, sourceKey, source); err != nil {
+		if errors.IsNotFound(err) {
+			// The source watch enqueues us once the secret appears
+			logger.Info("Source secret not found", "source", sourceKey)
+			return ctrl.Result{}, r.updateResourceStatus(ctx, secretCopy, secretcopyv1alpha1.ReasonSourceNotFound,
+				fmt.Sprintf("source secret %q not found", sourceKey.Name))
+		}
+		return ctrl.Result{}, err
+	}
+
+	// The spec cannot produce a valid copy of the current source data in any destination
+	if _, err := r.prepareContent(source, config); err != nil {
+		logger.Error(nil, "Invalid copy of source secret", "reason", err.Error())
+		return ctrl.Result{}, r.updateResourceStatus(ctx, secretCopy, secretcopyv1alpha1.ReasonInvalidSpec, err.Error())
+	}
+
+	logger.Info("Reconciling SecretCopy",
+		"source", sourceKey,
+		"destinations", len(secretCopy.Spec.Destinations),
+	)
+
+	previous := syncTargetsFromStatus(secretCopy.Status.SyncedTargets)
+	desired := make([]syncTarget, 0, len(secretCopy.Spec.Destinations))
+	synced := make([]syncTarget, 0, len(secretCopy.Spec.Destinations))
+
+	var syncErrors []string
+	for _, dst := range secretCopy.Spec.Destinations {
+		ref, dstConfig := destinationConfig(secretCopy, dst, config)
+		target := newSyncTarget(ref, dstConfig)
+		if slices.Contains(desired, target) {
+			continue
+		}
+		desired = append(desired, target)
+
+		if err := r.syncToCluster(ctx, source, ref, dstConfig); err != nil {
+			syncErrors = append(syncErrors, fmt.Sprintf("%s: %s", target, err.Error()))
+			if slices.Contains(previous, target) {
+				synced = append(synced, target)
+			}
+			continue
+		}
+		synced = append(synced, target)
+	}
+
+	// Copies left behind after a destination was removed from the spec
+	for _, target := range previous {
+		if slices.Contains(desired, target) {
+			continue
+		}
+		if config.DeletionPolicy != DeletionPolicyDelete {
+			logger.Info("Forgetting stale copy", "target", target.String(), "deletionPolicy", config.DeletionPolicy)
+			continue
+		}
+		if err := r.deleteTarget(ctx, source, target); err != nil {
+			syncErrors = append(syncErrors, fmt.Sprintf("%s: failed to prune stale copy: %s", target, err.Error()))
+			synced = append(synced, target)
+		}
+	}
+
+	secretCopy.Status.SyncedTargets = syncTargetsToStatus(synced)
+
+	if len(syncErrors) > 0 {
+		delay := calculateBackoff(int(secretCopy.Status.RetryCount))
+		if err := r.updateResourceStatus(ctx, secretCopy, secretcopyv1alpha1.ReasonSyncFailed,
+			strings.Join(syncErrors, "; ")); err != nil {
+			return ctrl.Result{}, err
+		}
+		logger.Info("Scheduling retry", "delay", delay, "errors", len(syncErrors))
+		return ctrl.Result{RequeueAfter: delay}, nil
+	}
+
+	if err := r.updateResourceStatus(ctx, secretCopy, secretcopyv1alpha1.ReasonSynced,
+		fmt.Sprintf("copied to %d destination(s)", len(synced))); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: r.ResyncPeriod}, nil
+}
+
+// reconcileResourceCleanup removes copies when deletionPolicy=Delete and releases the SecretCopy
+func (r *SecretCopyResourceReconciler) reconcileResourceCleanup(
+	ctx context.Context,
+	secretCopy *secretcopyv1alpha1.SecretCopy,
+) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	if !controllerutil.ContainsFinalizer(secretCopy, FinalizerCleanup) {
+		return ctrl.Result{}, nil
+	}
+
+	config, err := configFromSpec(secretCopy)
+	if err != nil {
+		// Policy is unknown without a valid spec, never delete copies blindly
+		logger.Error(nil, "Invalid SecretCopy spec, orphaning copies", "reason", err.Error())
+		return ctrl.Result{}, r.removeResourceFinalizer(ctx, secretCopy)
+	}
+
+	if config.DeletionPolicy == DeletionPolicyDelete {
+		// Copies are matched by their source reference, the source itself may be gone already
+		source := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+			Namespace: secretCopy.Namespace,
+			Name:      secretCopy.Spec.Source.Name,
+		}}
+
+		targets := syncTargetsFromStatus(secretCopy.Status.SyncedTargets)
+		for _, dst := range secretCopy.Spec.Destinations {
+			ref, dstConfig := destinationConfig(secretCopy, dst, config)
+			if target := newSyncTarget(ref, dstConfig); !slices.Contains(targets, target) {
+				targets = append(targets, target)
+			}
+		}
+
+		var remaining []syncTarget
+		var deleteErrors []string
+		for _, target := range targets {
+			if err := r.deleteTarget(ctx, source, target); err != nil {
+				deleteErrors = append(deleteErrors, fmt.Sprintf("%s: %s", target, err.Error()))
+				remaining = append(remaining, target)
+			}
+		}
+
+		if len(deleteErrors) > 0 {
+			delay := calculateBackoff(int(secretCopy.Status.RetryCount))
+			secretCopy.Status.SyncedTargets = syncTargetsToStatus(remaining)
+			if err := r.updateResourceStatus(ctx, secretCopy, secretcopyv1alpha1.ReasonSyncFailed,
+				strings.Join(deleteErrors, "; ")); err != nil {
+				return ctrl.Result{}, err
+			}
+			logger.Info("Scheduling cleanup retry", "delay", delay, "errors", len(deleteErrors))
+			return ctrl.Result{RequeueAfter: delay}, nil
+		}
+	}
+
+	logger.Info("Releasing SecretCopy", "deletionPolicy", config.DeletionPolicy)
+	return ctrl.Result{}, r.removeResourceFinalizer(ctx, secretCopy)
+}
+
+// ensureResourceFinalizer adds the cleanup finalizer to a SecretCopy or ClusterSecretCopy
+// for deletionPolicy=Delete and removes it otherwise
+func (r *SecretCopyReconciler) ensureResourceFinalizer(ctx context.Context, obj client.Object, policy DeletionPolicy) error {
+	if policy != DeletionPolicyDelete {
+		return r.removeResourceFinalizer(ctx, obj)
+	}
+	if controllerutil.ContainsFinalizer(obj, FinalizerCleanup) {
+		return nil
+	}
+
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	controllerutil.AddFinalizer(obj, FinalizerCleanup)
+	if err := r.Patch(ctx, obj, patch); err != nil {
+		return fmt.Errorf("failed to add finalizer: %w", err)
+	}
+	return nil
+}
+
+// removeResourceFinalizer removes the cleanup finalizer from a SecretCopy or ClusterSecretCopy if present
+func (r *SecretCopyReconciler) removeResourceFinalizer(ctx context.Context, obj client.Object) error {
+	if !controllerutil.ContainsFinalizer(obj, FinalizerCleanup) {
+		return nil
+	}
+
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	controllerutil.RemoveFinalizer(obj, FinalizerCleanup)
+	if err := r.Patch(ctx, obj, patch); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to remove finalizer: %w", err)
+	}
+	return nil
+}
+
+// updateResourceStatus sets the Ready condition from reason and writes the SecretCopy status
+func (r *SecretCopyResourceReconciler) updateResourceStatus(
+	ctx context.Context,
+	secretCopy *secretcopyv1alpha1.SecretCopy,
+	reason, message string,
+) error {
+	now := metav1.Now()
+	secretCopy.Status.ObservedGeneration = secretCopy.Generation
+	secretCopy.Status.LastSyncTime = &now
+	setReadyCondition(&secretCopy.Status.Conditions, &secretCopy.Status.RetryCount, secretCopy.Generation, reason, message)
+
+	if err := r.Status().Update(ctx, secretCopy); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to update SecretCopy status: %w", err)
+	}
+	return nil
+}
+
+// setReadyCondition sets the Ready condition from reason and manages retry count for exponential backoff.
+// ReasonSynced resets the retry count, ReasonSyncFailed increments it.
+func setReadyCondition(conditions *[]metav1.Condition, retryCount *int32, generation int64, reason, message string) {
+	status := metav1.ConditionFalse
+	switch reason {
+	case secretcopyv1alpha1.ReasonSynced:
+		status = metav1.ConditionTrue
+		*retryCount = 0
+	case secretcopyv1alpha1.ReasonSyncFailed:
+		*retryCount++
+	default:
+		*retryCount = 0
+	}
+
+	meta.SetStatusCondition(conditions, metav1.Condition{
+		Type:               secretcopyv1alpha1.ConditionReady,
+		Status:             status,
+		ObservedGeneration: generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+// configFromSpec converts a SecretCopy spec into the configuration shared by all destinations.
+// DstKubeconfigRefs and DstNamespace are set per destination by destinationConfig.
+func configFromSpec(secretCopy *secretcopyv1alpha1.SecretCopy) (*CopyConfig, error) {
+	if secretCopy.Spec.Source.Name == "" {
+		return nil, fmt.Errorf("spec.source.name is required")
+	}
+	if len(secretCopy.Spec.Destinations) == 0 {
+		return nil, fmt.Errorf("spec.destinations must not be empty")
+	}
+	for i, dst := range secretCopy.Spec.Destinations {
+		if dst.KubeconfigSecretRef.Namespace == "" || dst.KubeconfigSecretRef.Name == "" {
+			return nil, fmt.Errorf("spec.destinations[%d].kubeconfigSecretRef requires namespace and name", i)
+		}
+	}
+
+	strategy, err := ParseStrategy(secretCopy.Spec.Strategy)
+	if err != nil {
+		return nil, err
+	}
+
+	deletionPolicy, err := ParseDeletionPolicy(secretCopy.Spec.DeletionPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CopyConfig{
+		DstSecretName:  secretCopy.Spec.Source.Name,
+		DstType:        secretCopy.Spec.Type,
+		Strategy:       strategy,
+		FieldsMapping:  secretCopy.Spec.FieldsMapping,
+		DeletionPolicy: deletionPolicy,
+		OwnerAnnotations: map[string]string{
+			AnnotationSecretCopy: secretCopy.Namespace + "/" + secretCopy.Name,
+		},
+	}, nil
+}
+
+// destinationConfig returns the kubeconfig reference and configuration for a single destination
+func destinationConfig(
+	secretCopy *secretcopyv1alpha1.SecretCopy,
+	dst secretcopyv1alpha1.Destination,
+	config *CopyConfig,
+) (types.NamespacedName, *CopyConfig) {
+	ref := types.NamespacedName{
+		Namespace: dst.KubeconfigSecretRef.Namespace,
+		Name:      dst.KubeconfigSecretRef.Name,
+	}
+
+	dstConfig := *config
+	dstConfig.DstKubeconfigRefs = []types.NamespacedName{ref}
+	dstConfig.DstNamespace = dst.Namespace
+	if dstConfig.DstNamespace == "" {
+		dstConfig.DstNamespace = secretCopy.Namespace
+	}
+	return ref, &dstConfig
+}
+
+// syncTargetsFromStatus converts status targets into sync targets
+func syncTargetsFromStatus(targets []secretcopyv1alpha1.SyncedTarget) []syncTarget {
+	result := make([]syncTarget, 0, len(targets))
+	for _, t := range targets {
+		result = append(result, syncTarget{Cluster: t.Cluster, Namespace: t.Namespace, Name: t.Name})
+	}
+	return result
+}
+
+// syncTargetsToStatus converts sync targets into status targets sorted for stable output
+func syncTargetsToStatus(targets []syncTarget) []secretcopyv1alpha1.SyncedTarget {
+	if len(targets) == 0 {
+		return nil
+	}
+	sorted := slices.Clone(targets)
+	slices.SortFunc(sorted, func(a, b syncTarget) int {
+		return strings.Compare(a.String(), b.String())
+	})
+
+	result := make([]secretcopyv1alpha1.SyncedTarget, 0, len(sorted))
+	for _, t := range sorted {
+		result = append(result, secretcopyv1alpha1.SyncedTarget{Cluster: t.Cluster, Namespace: t.Namespace, Name: t.Name})
+	}
+	return result
+}
+
+// findSecretCopiesForSecret maps a secret to the SecretCopy resources that use it
+// as the source or as the kubeconfig of a destination cluster
+func (r *SecretCopyResourceReconciler) findSecretCopiesForSecret(ctx context.Context, obj client.Object) []reconcile.Request {
+	logger := log.FromContext(ctx)
+
+	secretCopies := &secretcopyv1alpha1.SecretCopyList{}
+	if err := r.List(ctx, secretCopies); err != nil {
+		logger.Error(err, "Failed to list SecretCopy resources for secret", "secret", client.ObjectKeyFromObject(obj))
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for i := range secretCopies.Items {
+		secretCopy := &secretCopies.Items[i]
+		if (secretCopy.Namespace == obj.GetNamespace() && secretCopy.Spec.Source.Name == obj.GetName()) ||
+			slices.ContainsFunc(secretCopy.Spec.Destinations, func(dst secretcopyv1alpha1.Destination) bool {
+				return dst.KubeconfigSecretRef.Namespace == obj.GetNamespace() &&
+					dst.KubeconfigSecretRef.Name == obj.GetName()
+			}) {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(secretCopy)})
+		}
+	}
+
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager
+func (r *SecretCopyResourceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		// Status updates do not bump generation, so they do not retrigger reconcile
+		For(&secretcopyv1alpha1.SecretCopy{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		// Source and kubeconfig secrets: changes enqueue every SecretCopy that uses them
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.findSecretCopiesForSecret),
+			builder.WithPredicates(predicate.Funcs{
+				UpdateFunc: func(e event.UpdateEvent) bool {
+					return sourceSpecChanged(e.ObjectOld, e.ObjectNew)
+				},
+				GenericFunc: func(e event.GenericEvent) bool {
+					return false
+				},
+			})).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: r.MaxConcurrentReconciles,
+		}).
+		Complete(r)
+}
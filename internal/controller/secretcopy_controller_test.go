@@ -0,0 +1,483 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	secretcopyv1alpha1 "secret-copy-operator/api/v1alpha1"
+	"secret-copy-operator/test/mocks"
+)
+
+var _ = Describe("SecretCopyResourceReconciler", func() {
+
+	Describe("configFromSpec", func() {
+		It("should convert spec into copy configuration", func() {
+			secretCopy := &secretcopyv1alpha1.SecretCopy{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-copy", Namespace: "default"},
+				Spec: secretcopyv1alpha1.SecretCopySpec{
+					Source: secretcopyv1alpha1.SourceReference{Name: "my-secret"},
+					Destinations: []secretcopyv1alpha1.Destination{
+						{KubeconfigSecretRef: secretcopyv1alpha1.KubeconfigSecretReference{Namespace: "clusters", Name: "workload"}},
+					},
+					FieldsMapping:  map[string]string{"user": "USERNAME"},
+					Strategy:       "ignore",
+					Type:           corev1.SecretTypeOpaque,
+					DeletionPolicy: "Delete",
+				},
+			}
+
+			config, err := configFromSpec(secretCopy)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.DstSecretName).To(Equal("my-secret"))
+			Expect(config.DstType).To(Equal(corev1.SecretTypeOpaque))
+			Expect(config.Strategy).To(Equal(StrategyIgnore))
+			Expect(config.DeletionPolicy).To(Equal(DeletionPolicyDelete))
+			Expect(config.FieldsMapping).To(Equal(map[string]string{"user": "USERNAME"}))
+			Expect(config.OwnerAnnotations).To(HaveKeyWithValue(AnnotationSecretCopy, "default/my-copy"))
+		})
+
+		It("should use defaults for empty strategy and deletion policy", func() {
+			secretCopy := &secretcopyv1alpha1.SecretCopy{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-copy", Namespace: "default"},
+				Spec: secretcopyv1alpha1.SecretCopySpec{
+					Source: secretcopyv1alpha1.SourceReference{Name: "my-secret"},
+					Destinations: []secretcopyv1alpha1.Destination{
+						{KubeconfigSecretRef: secretcopyv1alpha1.KubeconfigSecretReference{Namespace: "clusters", Name: "workload"}},
+					},
+				},
+			}
+
+			config, err := configFromSpec(secretCopy)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.Strategy).To(Equal(StrategyOverwrite))
+			Expect(config.DeletionPolicy).To(Equal(DeletionPolicyOrphan))
+		})
+
+		It("should return error for missing destinations", func() {
+			secretCopy := &secretcopyv1alpha1.SecretCopy{
+				Spec: secretcopyv1alpha1.SecretCopySpec{
+					Source: secretcopyv1alpha1.SourceReference{Name: "my-secret"},
+				},
+			}
+
+			_, err := configFromSpec(secretCopy)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should return error for incomplete kubeconfig reference", func() {
+			secretCopy := &secretcopyv1alpha1.SecretCopy{
+				Spec: secretcopyv1alpha1.SecretCopySpec{
+					Source: secretcopyv1alpha1.SourceReference{Name: "my-secret"},
+					Destinations: []secretcopyv1alpha1.Destination{
+						{KubeconfigSecretRef: secretcopyv1alpha1.KubeconfigSecretReference{Name: "workload"}},
+					},
+				},
+			}
+
+			_, err := configFromSpec(secretCopy)
+			Expect(err).To(MatchError(ContainSubstring("spec.destinations[0]")))
+		})
+
+		It("should return error for invalid strategy", func() {
+			secretCopy := &secretcopyv1alpha1.SecretCopy{
+				Spec: secretcopyv1alpha1.SecretCopySpec{
+					Source: secretcopyv1alpha1.SourceReference{Name: "my-secret"},
+					Destinations: []secretcopyv1alpha1.Destination{
+						{KubeconfigSecretRef: secretcopyv1alpha1.KubeconfigSecretReference{Namespace: "clusters", Name: "workload"}},
+					},
+					Strategy: "replace",
+				},
+			}
+
+			_, err := configFromSpec(secretCopy)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("Reconcile", func() {
+		var (
+			ctx               context.Context
+			scheme            *runtime.Scheme
+			mockCtrl          *gomock.Controller
+			mockClusterGetter *mocks.MockClusterClientGetter
+			kubeconfigSecret  *corev1.Secret
+			sourceSecret      *corev1.Secret
+			request           ctrl.Request
+		)
+
+		newReconciler := func(c client.Client) *SecretCopyResourceReconciler {
+			return &SecretCopyResourceReconciler{
+				SecretCopyReconciler: SecretCopyReconciler{
+					Client:              c,
+					Scheme:              scheme,
+					ClusterClientGetter: mockClusterGetter,
+					ClusterName:         "management",
+				},
+			}
+		}
+
+		newSecretCopy := func(destinations ...secretcopyv1alpha1.Destination) *secretcopyv1alpha1.SecretCopy {
+			return &secretcopyv1alpha1.SecretCopy{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-copy", Namespace: "default", Generation: 1},
+				Spec: secretcopyv1alpha1.SecretCopySpec{
+					Source:       secretcopyv1alpha1.SourceReference{Name: "my-secret"},
+					Destinations: destinations,
+				},
+			}
+		}
+
+		destination := func(name, namespace string) secretcopyv1alpha1.Destination {
+			return secretcopyv1alpha1.Destination{
+				KubeconfigSecretRef: secretcopyv1alpha1.KubeconfigSecretReference{Namespace: "clusters", Name: name},
+				Namespace:           namespace,
+			}
+		}
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			scheme = runtime.NewScheme()
+			Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+			Expect(secretcopyv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+			mockCtrl = gomock.NewController(GinkgoT())
+			mockClusterGetter = mocks.NewMockClusterClientGetter(mockCtrl)
+
+			kubeconfigSecret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "workload", Namespace: "clusters"},
+				Data:       map[string][]byte{"value": []byte("kubeconfig-data")},
+			}
+			sourceSecret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-secret",
+					Namespace: "default",
+					Annotations: map[string]string{
+						"team": "platform",
+					},
+				},
+				Data: map[string][]byte{"password": []byte("secret123")},
+			}
+			request = ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "my-copy"}}
+		})
+
+		AfterEach(func() {
+			mockCtrl.Finish()
+		})
+
+		It("should copy source secret and report Ready condition", func() {
+			secretCopy := newSecretCopy(destination("workload", "target-ns"))
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(secretCopy, sourceSecret, kubeconfigSecret).
+				WithStatusSubresource(&secretcopyv1alpha1.SecretCopy{}).
+				Build()
+			fakeTargetClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "target-ns"}}).
+				Build()
+			mockClusterGetter.EXPECT().GetClient(gomock.Any()).Return(fakeTargetClient, nil)
+
+			reconciler := newReconciler(fakeClient)
+			reconciler.ResyncPeriod = 10 * time.Minute
+
+			result, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(10 * time.Minute))
+
+			copied := &corev1.Secret{}
+			Expect(fakeTargetClient.Get(ctx, types.NamespacedName{Namespace: "target-ns", Name: "my-secret"}, copied)).To(Succeed())
+			Expect(copied.Data).To(Equal(sourceSecret.Data))
+			Expect(copied.Annotations).To(HaveKeyWithValue("team", "platform"))
+			Expect(copied.Annotations).To(HaveKeyWithValue(AnnotationSourceSecret, "default/my-secret"))
+			Expect(copied.Annotations).To(HaveKeyWithValue(AnnotationSecretCopy, "default/my-copy"))
+
+			updated := &secretcopyv1alpha1.SecretCopy{}
+			Expect(fakeClient.Get(ctx, request.NamespacedName, updated)).To(Succeed())
+			Expect(updated.Status.ObservedGeneration).To(Equal(int64(1)))
+			Expect(updated.Status.LastSyncTime).NotTo(BeNil())
+			Expect(updated.Status.RetryCount).To(BeZero())
+			Expect(updated.Status.SyncedTargets).To(Equal([]secretcopyv1alpha1.SyncedTarget{
+				{Cluster: "clusters/workload", Namespace: "target-ns", Name: "my-secret"},
+			}))
+			Expect(meta.IsStatusConditionTrue(updated.Status.Conditions, secretcopyv1alpha1.ConditionReady)).To(BeTrue())
+
+			// Source secret is left untouched
+			source := &corev1.Secret{}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(sourceSecret), source)).To(Succeed())
+			Expect(source.Annotations).NotTo(HaveKey(AnnotationLastSyncStatus))
+			Expect(source.Finalizers).To(BeEmpty())
+		})
+
+		It("should default destination namespace to the SecretCopy namespace", func() {
+			secretCopy := newSecretCopy(destination("workload", ""))
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(secretCopy, sourceSecret, kubeconfigSecret).
+				WithStatusSubresource(&secretcopyv1alpha1.SecretCopy{}).
+				Build()
+			fakeTargetClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}}).
+				Build()
+			mockClusterGetter.EXPECT().GetClient(gomock.Any()).Return(fakeTargetClient, nil)
+
+			_, err := newReconciler(fakeClient).Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			copied := &corev1.Secret{}
+			Expect(fakeTargetClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "my-secret"}, copied)).To(Succeed())
+		})
+
+		It("should report SourceNotFound without requeue", func() {
+			secretCopy := newSecretCopy(destination("workload", "target-ns"))
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(secretCopy, kubeconfigSecret).
+				WithStatusSubresource(&secretcopyv1alpha1.SecretCopy{}).
+				Build()
+
+			result, err := newReconciler(fakeClient).Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeZero())
+
+			updated := &secretcopyv1alpha1.SecretCopy{}
+			Expect(fakeClient.Get(ctx, request.NamespacedName, updated)).To(Succeed())
+			condition := meta.FindStatusCondition(updated.Status.Conditions, secretcopyv1alpha1.ConditionReady)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(secretcopyv1alpha1.ReasonSourceNotFound))
+		})
+
+		It("should report InvalidSpec without requeue", func() {
+			secretCopy := newSecretCopy(destination("workload", "target-ns"))
+			secretCopy.Spec.DeletionPolicy = "Retain"
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(secretCopy, sourceSecret, kubeconfigSecret).
+				WithStatusSubresource(&secretcopyv1alpha1.SecretCopy{}).
+				Build()
+
+			result, err := newReconciler(fakeClient).Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeZero())
+
+			updated := &secretcopyv1alpha1.SecretCopy{}
+			Expect(fakeClient.Get(ctx, request.NamespacedName, updated)).To(Succeed())
+			condition := meta.FindStatusCondition(updated.Status.Conditions, secretcopyv1alpha1.ConditionReady)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Reason).To(Equal(secretcopyv1alpha1.ReasonInvalidSpec))
+		})
+
+		It("should report InvalidSpec when the field mapping drops keys required by the type", func() {
+			secretCopy := newSecretCopy(destination("workload", "target-ns"))
+			secretCopy.Spec.Type = corev1.SecretTypeTLS
+			secretCopy.Spec.FieldsMapping = map[string]string{"password": "tls.crt"}
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(secretCopy, sourceSecret, kubeconfigSecret).
+				WithStatusSubresource(&secretcopyv1alpha1.SecretCopy{}).
+				Build()
+
+			result, err := newReconciler(fakeClient).Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeZero())
+
+			updated := &secretcopyv1alpha1.SecretCopy{}
+			Expect(fakeClient.Get(ctx, request.NamespacedName, updated)).To(Succeed())
+			condition := meta.FindStatusCondition(updated.Status.Conditions, secretcopyv1alpha1.ConditionReady)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Reason).To(Equal(secretcopyv1alpha1.ReasonInvalidSpec))
+			Expect(condition.Message).To(ContainSubstring("the copy is missing tls.key"))
+		})
+
+		It("should report SyncFailed and back off when a destination fails", func() {
+			secretCopy := newSecretCopy(destination("missing", "target-ns"))
+			secretCopy.Status.RetryCount = 1
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(secretCopy, sourceSecret).
+				WithStatusSubresource(&secretcopyv1alpha1.SecretCopy{}).
+				Build()
+
+			result, err := newReconciler(fakeClient).Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(calculateBackoff(1)))
+
+			updated := &secretcopyv1alpha1.SecretCopy{}
+			Expect(fakeClient.Get(ctx, request.NamespacedName, updated)).To(Succeed())
+			Expect(updated.Status.RetryCount).To(Equal(int32(2)))
+			condition := meta.FindStatusCondition(updated.Status.Conditions, secretcopyv1alpha1.ConditionReady)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Reason).To(Equal(secretcopyv1alpha1.ReasonSyncFailed))
+			Expect(condition.Message).To(ContainSubstring("clusters/missing:target-ns/my-secret: kubeconfig not found"))
+		})
+
+		It("should prune copies of removed destinations with deletionPolicy=Delete", func() {
+			secretCopy := newSecretCopy(destination("workload", "new-ns"))
+			secretCopy.Spec.DeletionPolicy = string(DeletionPolicyDelete)
+			secretCopy.Status.SyncedTargets = []secretcopyv1alpha1.SyncedTarget{
+				{Cluster: "clusters/workload", Namespace: "old-ns", Name: "my-secret"},
+			}
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(secretCopy, sourceSecret, kubeconfigSecret).
+				WithStatusSubresource(&secretcopyv1alpha1.SecretCopy{}).
+				Build()
+			staleCopy := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-secret",
+					Namespace: "old-ns",
+					Annotations: map[string]string{
+						AnnotationSourceCluster: "management",
+						AnnotationSourceSecret:  "default/my-secret",
+					},
+				},
+			}
+			fakeTargetClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "new-ns"}}, staleCopy).
+				Build()
+			mockClusterGetter.EXPECT().GetClient(gomock.Any()).Return(fakeTargetClient, nil).Times(2)
+
+			_, err := newReconciler(fakeClient).Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = fakeTargetClient.Get(ctx, client.ObjectKeyFromObject(staleCopy), &corev1.Secret{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			updated := &secretcopyv1alpha1.SecretCopy{}
+			Expect(fakeClient.Get(ctx, request.NamespacedName, updated)).To(Succeed())
+			Expect(updated.Finalizers).To(ContainElement(FinalizerCleanup))
+			Expect(updated.Status.SyncedTargets).To(Equal([]secretcopyv1alpha1.SyncedTarget{
+				{Cluster: "clusters/workload", Namespace: "new-ns", Name: "my-secret"},
+			}))
+		})
+
+		It("should delete copies and release SecretCopy on deletion with deletionPolicy=Delete", func() {
+			now := metav1.Now()
+			secretCopy := newSecretCopy(destination("workload", "target-ns"))
+			secretCopy.Spec.DeletionPolicy = string(DeletionPolicyDelete)
+			secretCopy.Finalizers = []string{FinalizerCleanup}
+			secretCopy.DeletionTimestamp = &now
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(secretCopy, kubeconfigSecret).
+				WithStatusSubresource(&secretcopyv1alpha1.SecretCopy{}).
+				Build()
+			copied := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-secret",
+					Namespace: "target-ns",
+					Annotations: map[string]string{
+						AnnotationSourceCluster: "management",
+						AnnotationSourceSecret:  "default/my-secret",
+					},
+				},
+			}
+			fakeTargetClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(copied).Build()
+			mockClusterGetter.EXPECT().GetClient(gomock.Any()).Return(fakeTargetClient, nil)
+
+			_, err := newReconciler(fakeClient).Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = fakeTargetClient.Get(ctx, client.ObjectKeyFromObject(copied), &corev1.Secret{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			// Removing the last finalizer lets the fake client finish deletion
+			err = fakeClient.Get(ctx, request.NamespacedName, &secretcopyv1alpha1.SecretCopy{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should keep copies on deletion with deletionPolicy=Orphan", func() {
+			now := metav1.Now()
+			secretCopy := newSecretCopy(destination("workload", "target-ns"))
+			secretCopy.Finalizers = []string{FinalizerCleanup}
+			secretCopy.DeletionTimestamp = &now
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(secretCopy, kubeconfigSecret).
+				WithStatusSubresource(&secretcopyv1alpha1.SecretCopy{}).
+				Build()
+
+			_, err := newReconciler(fakeClient).Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = fakeClient.Get(ctx, request.NamespacedName, &secretcopyv1alpha1.SecretCopy{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Describe("findSecretCopiesForSecret", func() {
+		It("should map source and kubeconfig secrets to SecretCopy resources", func() {
+			scheme := runtime.NewScheme()
+			Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+			Expect(secretcopyv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+			bySource := &secretcopyv1alpha1.SecretCopy{
+				ObjectMeta: metav1.ObjectMeta{Name: "by-source", Namespace: "default"},
+				Spec: secretcopyv1alpha1.SecretCopySpec{
+					Source: secretcopyv1alpha1.SourceReference{Name: "my-secret"},
+				},
+			}
+			byKubeconfig := &secretcopyv1alpha1.SecretCopy{
+				ObjectMeta: metav1.ObjectMeta{Name: "by-kubeconfig", Namespace: "apps"},
+				Spec: secretcopyv1alpha1.SecretCopySpec{
+					Source: secretcopyv1alpha1.SourceReference{Name: "other"},
+					Destinations: []secretcopyv1alpha1.Destination{
+						{KubeconfigSecretRef: secretcopyv1alpha1.KubeconfigSecretReference{Namespace: "default", Name: "my-secret"}},
+					},
+				},
+			}
+			otherNamespace := &secretcopyv1alpha1.SecretCopy{
+				ObjectMeta: metav1.ObjectMeta{Name: "other-namespace", Namespace: "apps"},
+				Spec: secretcopyv1alpha1.SecretCopySpec{
+					Source: secretcopyv1alpha1.SourceReference{Name: "my-secret"},
+				},
+			}
+			reconciler := &SecretCopyResourceReconciler{
+				SecretCopyReconciler: SecretCopyReconciler{
+					Client: fake.NewClientBuilder().
+						WithScheme(scheme).
+						WithObjects(bySource, byKubeconfig, otherNamespace).
+						Build(),
+				},
+			}
+
+			secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "my-secret", Namespace: "default"}}
+			Expect(reconciler.findSecretCopiesForSecret(context.Background(), secret)).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "by-source"}},
+				reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "apps", Name: "by-kubeconfig"}},
+			))
+		})
+	})
+})
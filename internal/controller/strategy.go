@@ -26,6 +26,8 @@ const (
 	StrategyOverwrite Strategy = "overwrite"
 	// StrategyIgnore skips existing secrets without updating
 	StrategyIgnore Strategy = "ignore"
+	// StrategyMerge updates only the keys written by the operator, keys added to the copy by others are kept
+	StrategyMerge Strategy = "merge"
 )
 
 // ParseStrategy parses and validates strategy from annotation value.
@@ -35,8 +37,8 @@ func ParseStrategy(value string) (Strategy, error) {
 		return StrategyOverwrite, nil
 	}
 	s := Strategy(value)
-	if s != StrategyOverwrite && s != StrategyIgnore {
-		return "", fmt.Errorf("invalid strategy %q, expected %q or %q", value, StrategyOverwrite, StrategyIgnore)
+	if s != StrategyOverwrite && s != StrategyIgnore && s != StrategyMerge {
+		return "", fmt.Errorf("invalid strategy %q, expected %q, %q or %q", value, StrategyOverwrite, StrategyIgnore, StrategyMerge)
 	}
 	return s, nil
 }
@@ -0,0 +1,119 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// inClusterRef is the destination reference of InClusterDestination
+var inClusterRef = types.NamespacedName{Name: InClusterDestination}
+
+// isInCluster returns true if the destination reference targets the operator's own cluster
+func isInCluster(kubeconfigRef types.NamespacedName) bool {
+	return kubeconfigRef == inClusterRef
+}
+
+// clusterName returns the destination cluster name used in targets, status messages and metrics
+func clusterName(kubeconfigRef types.NamespacedName) string {
+	if isInCluster(kubeconfigRef) {
+		return InClusterDestination
+	}
+	return kubeconfigRef.String()
+}
+
+// syncTarget identifies a copy of the source secret in a destination cluster
+type syncTarget struct {
+	// Cluster is the kubeconfig secret reference (namespace/name) or InClusterDestination
+	Cluster   string `json:"cluster"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// Kind of the copy if it differs from the source kind
+	Kind string `json:"kind,omitempty"`
+}
+
+// newSyncTarget returns the target for the given destination cluster and configuration
+func newSyncTarget(kubeconfigRef types.NamespacedName, config *CopyConfig) syncTarget {
+	return syncTarget{
+		Cluster:   clusterName(kubeconfigRef),
+		Namespace: config.DstNamespace,
+		Name:      config.DstSecretName,
+		Kind:      config.DstKind,
+	}
+}
+
+// copyKind returns the kind of the copy of source described by the target
+func (t syncTarget) copyKind(source client.Object) string {
+	if t.Kind != "" {
+		return t.Kind
+	}
+	return sourceKind(source)
+}
+
+// kubeconfigRef returns the kubeconfig secret reference of the destination cluster
+func (t syncTarget) kubeconfigRef() (types.NamespacedName, error) {
+	if t.Cluster == InClusterDestination {
+		return inClusterRef, nil
+	}
+	parts := strings.SplitN(t.Cluster, "/", 2)
+	if len(parts) != 2 {
+		return types.NamespacedName{}, fmt.Errorf("invalid cluster reference %q", t.Cluster)
+	}
+	return types.NamespacedName{Namespace: parts[0], Name: parts[1]}, nil
+}
+
+// String returns the target in cluster:namespace/name form, prefixed with the kind if set
+func (t syncTarget) String() string {
+	if t.Kind != "" {
+		return t.Cluster + ":" + t.Kind + "/" + t.Namespace + "/" + t.Name
+	}
+	return t.Cluster + ":" + t.Namespace + "/" + t.Name
+}
+
+// getSyncedTargets reads previously synced targets from the status annotation.
+// Returns nil if the annotation is missing or malformed.
+func getSyncedTargets(source client.Object) []syncTarget {
+	value := source.GetAnnotations()[AnnotationSyncedTargets]
+	if value == "" {
+		return nil
+	}
+	var targets []syncTarget
+	if err := json.Unmarshal([]byte(value), &targets); err != nil {
+		return nil
+	}
+	return targets
+}
+
+// formatSyncedTargets encodes targets for the status annotation in a stable order
+func formatSyncedTargets(targets []syncTarget) string {
+	if len(targets) == 0 {
+		return ""
+	}
+	sorted := make([]syncTarget, len(targets))
+	copy(sorted, targets)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].String() < sorted[j].String()
+	})
+	data, _ := json.Marshal(sorted)
+	return string(data)
+}
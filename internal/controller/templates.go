@@ -0,0 +1,295 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// maxTemplateOutput caps the rendered value of a data template and every string built while
+// rendering it, the size limit of a Secret or ConfigMap
+const maxTemplateOutput = 1 << 20
+
+// errTemplateOutputTooLarge is returned when rendering would produce more than maxTemplateOutput bytes
+var errTemplateOutputTooLarge = fmt.Errorf("output exceeds %d bytes", maxTemplateOutput)
+
+// templateFuncs is the function set available to data templates. Functions are pure, templates cannot
+// reach the cluster, files or environment. Builtins building strings are replaced by versions that refuse
+// results over maxTemplateOutput, so that a template cannot exhaust memory without writing output.
+var templateFuncs = template.FuncMap{
+	"b64enc": func(s string) (string, error) {
+		return limitString(base64.StdEncoding.EncodeToString([]byte(s)))
+	},
+	"b64dec": func(s string) (string, error) {
+		decoded, err := base64.StdEncoding.DecodeString(s)
+		return string(decoded), err
+	},
+	"toJson": func(v any) (string, error) {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return limitString(string(data))
+	},
+	"sha256": func(s string) string {
+		sum := sha256.Sum256([]byte(s))
+		return hex.EncodeToString(sum[:])
+	},
+	"indent": func(spaces int, s string) (string, error) {
+		if spaces < 0 || spaces*(strings.Count(s, "\n")+1)+len(s) > maxTemplateOutput {
+			return "", fmt.Errorf("indent: %w", errTemplateOutputTooLarge)
+		}
+		pad := strings.Repeat(" ", spaces)
+		return pad + strings.ReplaceAll(s, "\n", "\n"+pad), nil
+	},
+	"print": func(args ...any) (string, error) {
+		return boundedFormat(argsSize(args), func() string { return fmt.Sprint(args...) })
+	},
+	"println": func(args ...any) (string, error) {
+		return boundedFormat(argsSize(args), func() string { return fmt.Sprintln(args...) })
+	},
+	"printf": func(format string, args ...any) (string, error) {
+		return boundedFormat(len(format)+formatWidths(format)+argsSize(args), func() string {
+			return fmt.Sprintf(format, args...)
+		})
+	},
+	"html": func(args ...any) (string, error) {
+		return boundedFormat(argsSize(args), func() string { return template.HTMLEscaper(args...) })
+	},
+	"js": func(args ...any) (string, error) {
+		return boundedFormat(argsSize(args), func() string { return template.JSEscaper(args...) })
+	},
+	"urlquery": func(args ...any) (string, error) {
+		return boundedFormat(argsSize(args), func() string { return template.URLQueryEscaper(args...) })
+	},
+}
+
+// limitString returns s or an error if it is longer than maxTemplateOutput
+func limitString(s string) (string, error) {
+	if len(s) > maxTemplateOutput {
+		return "", errTemplateOutputTooLarge
+	}
+	return s, nil
+}
+
+// boundedFormat refuses to format arguments whose estimated size is over maxTemplateOutput,
+// then checks the actual result, which escaping may make a few times larger than the estimate
+func boundedFormat(estimate int, format func() string) (string, error) {
+	if estimate > maxTemplateOutput {
+		return "", errTemplateOutputTooLarge
+	}
+	return limitString(format())
+}
+
+// argsSize estimates the size of template function arguments when formatted
+func argsSize(args []any) int {
+	size := 0
+	for _, arg := range args {
+		switch v := arg.(type) {
+		case string:
+			size += len(v)
+		case map[string]string:
+			size += dataSize(v)
+		case templateData:
+			size += dataSize(v.Data) + len(v.SourceNamespace) + len(v.SourceName)
+		default:
+			size += 32
+		}
+	}
+	return size
+}
+
+// dataSize returns the size of keys and values of template data
+func dataSize(data map[string]string) int {
+	size := 0
+	for k, v := range data {
+		size += len(k) + len(v) + 2
+	}
+	return size
+}
+
+// formatWidths returns the sum of the numbers in a printf format, an upper bound of the padding it adds
+func formatWidths(format string) int {
+	sum, n := 0, 0
+	for _, c := range format + " " {
+		if c >= '0' && c <= '9' {
+			n = min(n*10+int(c-'0'), maxTemplateOutput+1)
+			continue
+		}
+		sum, n = min(sum+n, maxTemplateOutput+1), 0
+	}
+	return sum
+}
+
+// limitedWriter collects rendered output and fails once it exceeds maxTemplateOutput,
+// which aborts template execution
+type limitedWriter struct {
+	strings.Builder
+}
+
+// Write appends p unless the output would exceed maxTemplateOutput
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	if w.Len()+len(p) > maxTemplateOutput {
+		return 0, errTemplateOutputTooLarge
+	}
+	return w.Builder.Write(p)
+}
+
+// checkTemplateNode rejects constructs whose rendering time is not bounded by the source data:
+// range over anything but .Data, nested range and template calls
+func checkTemplateNode(node parse.Node, inRange bool) error {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return nil
+		}
+		for _, child := range n.Nodes {
+			if err := checkTemplateNode(child, inRange); err != nil {
+				return err
+			}
+		}
+	case *parse.IfNode:
+		return checkTemplateBranch(&n.BranchNode, inRange)
+	case *parse.WithNode:
+		return checkTemplateBranch(&n.BranchNode, inRange)
+	case *parse.RangeNode:
+		if inRange {
+			return fmt.Errorf("nested range is not allowed")
+		}
+		if !rangesOverData(n.Pipe) {
+			return fmt.Errorf("range is only allowed over .Data, got %q", n.Pipe.String())
+		}
+		return checkTemplateBranch(&n.BranchNode, true)
+	case *parse.TemplateNode:
+		return fmt.Errorf("template calls are not allowed, got %q", n.Name)
+	}
+	return nil
+}
+
+// checkTemplateBranch checks both lists of an if, with or range
+func checkTemplateBranch(n *parse.BranchNode, inRange bool) error {
+	if err := checkTemplateNode(n.List, inRange); err != nil {
+		return err
+	}
+	return checkTemplateNode(n.ElseList, inRange)
+}
+
+// rangesOverData returns true if the range pipeline is .Data or $.Data
+func rangesOverData(pipe *parse.PipeNode) bool {
+	if len(pipe.Cmds) != 1 || len(pipe.Cmds[0].Args) != 1 {
+		return false
+	}
+	switch arg := pipe.Cmds[0].Args[0].(type) {
+	case *parse.FieldNode:
+		return slices.Equal(arg.Ident, []string{"Data"})
+	case *parse.VariableNode:
+		return slices.Equal(arg.Ident, []string{"$", "Data"})
+	}
+	return false
+}
+
+// templateData is the data of data templates
+type templateData struct {
+	// Data holds source values as strings, including binaryData of a ConfigMap
+	Data            map[string]string
+	SourceNamespace string
+	SourceName      string
+}
+
+// parseDataTemplates parses data templates from annotations with AnnotationTemplatePrefix,
+// keyed by the destination key. Returns nil if there are none.
+func parseDataTemplates(annotations map[string]string) (map[string]*template.Template, error) {
+	var templates map[string]*template.Template
+	for key, value := range annotations {
+		dstKey, ok := strings.CutPrefix(key, AnnotationTemplatePrefix)
+		if !ok {
+			continue
+		}
+		if msgs := validation.IsConfigMapKey(dstKey); len(msgs) > 0 {
+			return nil, fmt.Errorf("invalid data template %q: %s", key, strings.Join(msgs, ", "))
+		}
+
+		tmpl, err := template.New(dstKey).Funcs(templateFuncs).Option("missingkey=error").Parse(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid data template %q: %w", key, err)
+		}
+		if err := checkTemplateNode(tmpl.Root, false); err != nil {
+			return nil, fmt.Errorf("invalid data template %q: %w", key, err)
+		}
+		if templates == nil {
+			templates = make(map[string]*template.Template)
+		}
+		templates[dstKey] = tmpl
+	}
+	return templates, nil
+}
+
+// renderDataTemplates renders the data templates of config over the source values
+func renderDataTemplates(source client.Object, config *CopyConfig) (map[string][]byte, error) {
+	if len(config.DataTemplates) == 0 {
+		return nil, nil
+	}
+
+	data := templateData{
+		Data:            make(map[string]string),
+		SourceNamespace: source.GetNamespace(),
+		SourceName:      source.GetName(),
+	}
+	switch src := source.(type) {
+	case *corev1.Secret:
+		for k, v := range src.Data {
+			data.Data[k] = string(v)
+		}
+	case *corev1.ConfigMap:
+		for k, v := range src.BinaryData {
+			data.Data[k] = string(v)
+		}
+		for k, v := range src.Data {
+			data.Data[k] = v
+		}
+	}
+
+	// Render in a stable order so that the same error is reported every time
+	keys := make([]string, 0, len(config.DataTemplates))
+	for key := range config.DataTemplates {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	rendered := make(map[string][]byte, len(keys))
+	for _, key := range keys {
+		var value limitedWriter
+		if err := config.DataTemplates[key].Execute(&value, data); err != nil {
+			return nil, fmt.Errorf("failed to render data template %q: %w", key, err)
+		}
+		rendered[key] = []byte(value.String())
+	}
+	return rendered, nil
+}
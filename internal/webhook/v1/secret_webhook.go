@@ -0,0 +1,113 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"secret-copy-operator/internal/controller"
+)
+
+// log is for logging in this package.
+var secretlog = logf.Log.WithName("secret-resource")
+
+// SetupSecretWebhookWithManager registers the webhook for Secret in the manager.
+func SetupSecretWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&corev1.Secret{}).
+		WithValidator(&SecretCustomValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate--v1-secret,mutating=false,failurePolicy=fail,sideEffects=None,groups="",resources=secrets,verbs=create;update,versions=v1,name=vsecret-v1.kb.io,admissionReviewVersions=v1
+
+// SecretCustomValidator validates copy configuration of Secrets labeled for copying.
+// Unlabeled Secrets are always allowed; the webhook configuration also limits
+// calls to labeled Secrets with an objectSelector.
+type SecretCustomValidator struct{}
+
+var _ webhook.CustomValidator = &SecretCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type Secret.
+func (v *SecretCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	secret, ok := obj.(*corev1.Secret)
+	if !ok {
+		return nil, fmt.Errorf("expected a Secret object but got %T", obj)
+	}
+	secretlog.V(1).Info("Validation for Secret upon creation", "name", secret.GetName(), "namespace", secret.GetNamespace())
+
+	return nil, validateSecret(secret)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type Secret.
+func (v *SecretCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldSecret, ok := oldObj.(*corev1.Secret)
+	if !ok {
+		return nil, fmt.Errorf("expected a Secret object for the oldObj but got %T", oldObj)
+	}
+	secret, ok := newObj.(*corev1.Secret)
+	if !ok {
+		return nil, fmt.Errorf("expected a Secret object for the newObj but got %T", newObj)
+	}
+	secretlog.V(1).Info("Validation for Secret upon update", "name", secret.GetName(), "namespace", secret.GetNamespace())
+
+	// Status, finalizer and data updates of an already labeled secret must never be blocked,
+	// otherwise the operator could not report errors or release secrets created before the webhook
+	if oldSecret.Labels[controller.LabelEnabled] == "true" &&
+		reflect.DeepEqual(configAnnotations(oldSecret.Annotations), configAnnotations(secret.Annotations)) {
+		return nil, nil
+	}
+
+	return nil, validateSecret(secret)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type Secret.
+func (v *SecretCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateSecret returns an error if a labeled secret has invalid copy configuration
+func validateSecret(secret *corev1.Secret) error {
+	if secret.Labels[controller.LabelEnabled] != "true" || !secret.DeletionTimestamp.IsZero() {
+		return nil
+	}
+	if err := controller.ValidateConfig(secret); err != nil {
+		return fmt.Errorf("invalid secret-copy configuration: %w", err)
+	}
+	return nil
+}
+
+// configAnnotations returns annotations that configure copying, status annotations are excluded
+func configAnnotations(annotations map[string]string) map[string]string {
+	result := make(map[string]string)
+	for k, v := range annotations {
+		// secret-copy.in-cloud.io/*, strategy.secret-copy.in-cloud.io/*, fields.secret-copy.in-cloud.io/*
+		if strings.Contains(k, controller.LabelEnabled+"/") && !strings.HasPrefix(k, controller.AnnotationStatusPrefix) {
+			result[k] = v
+		}
+	}
+	return result
+}
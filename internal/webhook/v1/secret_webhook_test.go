@@ -0,0 +1,176 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"secret-copy-operator/internal/controller"
+)
+
+var _ = Describe("Secret Webhook", func() {
+	var (
+		ctx       context.Context
+		validator *SecretCustomValidator
+	)
+
+	newSecret := func(annotations map[string]string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "test-secret",
+				Namespace:   "default",
+				Labels:      map[string]string{controller.LabelEnabled: "true"},
+				Annotations: annotations,
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		validator = &SecretCustomValidator{}
+	})
+
+	Context("When creating a Secret", func() {
+		It("should allow a labeled secret with valid configuration", func() {
+			secret := newSecret(map[string]string{
+				controller.AnnotationDstKubeconfig: "ns/kubeconfig",
+			})
+
+			_, err := validator.ValidateCreate(ctx, secret)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should deny a labeled secret with invalid configuration", func() {
+			secret := newSecret(map[string]string{
+				controller.AnnotationDstKubeconfig: "ns/kubeconfig",
+				controller.AnnotationResyncPeriod:  "often",
+			})
+
+			_, err := validator.ValidateCreate(ctx, secret)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid secret-copy configuration"))
+			Expect(err.Error()).To(ContainSubstring(controller.AnnotationResyncPeriod))
+		})
+
+		It("should deny a labeled secret without destinations", func() {
+			secret := newSecret(nil)
+
+			_, err := validator.ValidateCreate(ctx, secret)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should allow a labeled secret copied in-cluster without a destination cluster", func() {
+			secret := newSecret(map[string]string{
+				controller.AnnotationDstNamespace: "apps",
+			})
+
+			_, err := validator.ValidateCreate(ctx, secret)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should allow an unlabeled secret with any annotations", func() {
+			secret := newSecret(map[string]string{
+				controller.AnnotationDstKubeconfig: "invalid",
+			})
+			secret.Labels = nil
+
+			_, err := validator.ValidateCreate(ctx, secret)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject objects other than Secret", func() {
+			_, err := validator.ValidateCreate(ctx, &corev1.ConfigMap{})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("When updating a Secret", func() {
+		It("should deny adding the label to a secret with invalid configuration", func() {
+			oldSecret := newSecret(map[string]string{
+				controller.AnnotationDstKubeconfig: "invalid",
+			})
+			oldSecret.Labels = nil
+			secret := oldSecret.DeepCopy()
+			secret.Labels = map[string]string{controller.LabelEnabled: "true"}
+
+			_, err := validator.ValidateUpdate(ctx, oldSecret, secret)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should deny changing configuration to an invalid one", func() {
+			oldSecret := newSecret(map[string]string{
+				controller.AnnotationDstKubeconfig: "ns/kubeconfig",
+			})
+			secret := oldSecret.DeepCopy()
+			secret.Annotations[controller.AnnotationDstNamespace] = "Invalid_NS"
+
+			_, err := validator.ValidateUpdate(ctx, oldSecret, secret)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(controller.AnnotationDstNamespace))
+		})
+
+		It("should allow status and data updates when configuration is unchanged", func() {
+			// Secret created before the webhook was enabled
+			oldSecret := newSecret(map[string]string{
+				controller.AnnotationDstKubeconfig: "invalid",
+			})
+			secret := oldSecret.DeepCopy()
+			secret.Annotations[controller.AnnotationLastSyncStatus] = "Error: invalid"
+			secret.Finalizers = []string{controller.FinalizerCleanup}
+			secret.Data = map[string][]byte{"key": []byte("value")}
+
+			_, err := validator.ValidateUpdate(ctx, oldSecret, secret)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should allow removing the label", func() {
+			oldSecret := newSecret(map[string]string{
+				controller.AnnotationDstKubeconfig: "invalid",
+			})
+			secret := oldSecret.DeepCopy()
+			secret.Labels = nil
+
+			_, err := validator.ValidateUpdate(ctx, oldSecret, secret)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should allow updates of a deleting secret", func() {
+			oldSecret := newSecret(map[string]string{
+				controller.AnnotationDstKubeconfig: "ns/kubeconfig",
+			})
+			secret := oldSecret.DeepCopy()
+			now := metav1.Now()
+			secret.DeletionTimestamp = &now
+			secret.Annotations[controller.AnnotationDstKubeconfig] = "invalid"
+
+			_, err := validator.ValidateUpdate(ctx, oldSecret, secret)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Context("When deleting a Secret", func() {
+		It("should always allow deletion", func() {
+			_, err := validator.ValidateDelete(ctx, newSecret(nil))
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})